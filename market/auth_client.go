@@ -0,0 +1,323 @@
+package market
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync/atomic"
+	"time"
+)
+
+// AuthenticatedClient 在 APIClient 的公共行情接口之上，叠加 Binance USDT-M
+// 合约的私有接口（下单、账户、持仓），并负责请求签名。
+type AuthenticatedClient struct {
+	*APIClient
+	apiKey    string
+	apiSecret string
+	// timeOffsetMs 是本地时间与 Binance 服务器时间的差值（毫秒），通过
+	// SyncServerTime 获取，用于避免签名请求中的 timestamp 超出 recvWindow
+	// 触发的 -1021 错误。
+	timeOffsetMs int64
+}
+
+// NewAuthenticatedClient 创建一个可下单、查询账户的已认证客户端。
+func NewAuthenticatedClient(apiKey, secret string) *AuthenticatedClient {
+	return &AuthenticatedClient{
+		APIClient: NewAPIClient(),
+		apiKey:    apiKey,
+		apiSecret: secret,
+	}
+}
+
+// serverTime 对应 GET /fapi/v1/time 的返回。
+type serverTime struct {
+	ServerTime int64 `json:"serverTime"`
+}
+
+// SyncServerTime 拉取 Binance 服务器时间并更新本地时间偏移，应在下单前及
+// 之后定期调用（如每 30 分钟），否则本地时钟漂移可能导致 -1021 错误。
+func (c *AuthenticatedClient) SyncServerTime() error {
+	resp, err := c.client.Get(fmt.Sprintf("%s/fapi/v1/time", baseURL))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	var st serverTime
+	if err := json.Unmarshal(body, &st); err != nil {
+		return err
+	}
+
+	localMs := time.Now().UnixMilli()
+	atomic.StoreInt64(&c.timeOffsetMs, st.ServerTime-localMs)
+	return nil
+}
+
+func (c *AuthenticatedClient) timestamp() int64 {
+	return time.Now().UnixMilli() + atomic.LoadInt64(&c.timeOffsetMs)
+}
+
+// sign 为查询参数追加 timestamp、recvWindow 与 HMAC-SHA256 签名，返回最终
+// 可直接作为请求体/查询串使用的编码结果。
+func (c *AuthenticatedClient) sign(q url.Values) string {
+	q.Set("timestamp", strconv.FormatInt(c.timestamp(), 10))
+	q.Set("recvWindow", "5000")
+
+	payload := q.Encode()
+	mac := hmac.New(sha256.New, []byte(c.apiSecret))
+	mac.Write([]byte(payload))
+	signature := hex.EncodeToString(mac.Sum(nil))
+
+	return payload + "&signature=" + signature
+}
+
+// signedRequest 发起一个带签名的私有接口请求。
+func (c *AuthenticatedClient) signedRequest(method, path string, q url.Values) ([]byte, error) {
+	if q == nil {
+		q = url.Values{}
+	}
+	reqURL := fmt.Sprintf("%s%s?%s", baseURL, path, c.sign(q))
+
+	req, err := http.NewRequest(method, reqURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-MBX-APIKEY", c.apiKey)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("market: binance futures private api error (status %d): %s", resp.StatusCode, string(body))
+	}
+	return body, nil
+}
+
+// buildOrderParams 把 OrderRequest 转换成 Binance /fapi/v1/order 要求的查询
+// 参数，未设置的可选字段不会被写入。
+func buildOrderParams(req OrderRequest) url.Values {
+	q := url.Values{}
+	q.Set("symbol", normalizeBinanceSymbol(req.Symbol))
+	q.Set("side", string(req.Side))
+	q.Set("type", string(req.Type))
+
+	if req.PositionSide != "" {
+		q.Set("positionSide", string(req.PositionSide))
+	}
+	if req.Quantity != 0 {
+		q.Set("quantity", strconv.FormatFloat(req.Quantity, 'f', -1, 64))
+	}
+	if req.Price != 0 {
+		q.Set("price", strconv.FormatFloat(req.Price, 'f', -1, 64))
+	}
+	if req.StopPrice != 0 {
+		q.Set("stopPrice", strconv.FormatFloat(req.StopPrice, 'f', -1, 64))
+	}
+	if req.CallbackRate != 0 {
+		q.Set("callbackRate", strconv.FormatFloat(req.CallbackRate, 'f', -1, 64))
+	}
+	if req.TimeInForce != "" {
+		q.Set("timeInForce", string(req.TimeInForce))
+	}
+	if req.ReduceOnly {
+		q.Set("reduceOnly", "true")
+	}
+	if req.ClientOrderID != "" {
+		q.Set("newClientOrderId", req.ClientOrderID)
+	}
+	return q
+}
+
+// PlaceOrder 下单，对应 POST /fapi/v1/order。
+func (c *AuthenticatedClient) PlaceOrder(req OrderRequest) (*Order, error) {
+	body, err := c.signedRequest(http.MethodPost, "/fapi/v1/order", buildOrderParams(req))
+	if err != nil {
+		return nil, err
+	}
+	var order Order
+	if err := json.Unmarshal(body, &order); err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+// CancelOrder 撤单，对应 DELETE /fapi/v1/order。
+func (c *AuthenticatedClient) CancelOrder(symbol string, orderID int64) (*Order, error) {
+	q := url.Values{}
+	q.Set("symbol", normalizeBinanceSymbol(symbol))
+	q.Set("orderId", strconv.FormatInt(orderID, 10))
+
+	body, err := c.signedRequest(http.MethodDelete, "/fapi/v1/order", q)
+	if err != nil {
+		return nil, err
+	}
+	var order Order
+	if err := json.Unmarshal(body, &order); err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+// GetOpenOrders 查询当前挂单，对应 GET /fapi/v1/openOrders。symbol 为空时
+// 返回所有交易对的挂单。
+func (c *AuthenticatedClient) GetOpenOrders(symbol string) ([]Order, error) {
+	q := url.Values{}
+	if symbol != "" {
+		q.Set("symbol", normalizeBinanceSymbol(symbol))
+	}
+
+	body, err := c.signedRequest(http.MethodGet, "/fapi/v1/openOrders", q)
+	if err != nil {
+		return nil, err
+	}
+	var orders []Order
+	if err := json.Unmarshal(body, &orders); err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
+
+// GetOrderHistory 查询历史订单，对应 GET /fapi/v1/allOrders。
+func (c *AuthenticatedClient) GetOrderHistory(symbol string, limit int) ([]Order, error) {
+	q := url.Values{}
+	q.Set("symbol", normalizeBinanceSymbol(symbol))
+	if limit > 0 {
+		q.Set("limit", strconv.Itoa(limit))
+	}
+
+	body, err := c.signedRequest(http.MethodGet, "/fapi/v1/allOrders", q)
+	if err != nil {
+		return nil, err
+	}
+	var orders []Order
+	if err := json.Unmarshal(body, &orders); err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
+
+// GetAccount 查询账户资产，对应 GET /fapi/v2/account。
+func (c *AuthenticatedClient) GetAccount() (*Account, error) {
+	body, err := c.signedRequest(http.MethodGet, "/fapi/v2/account", nil)
+	if err != nil {
+		return nil, err
+	}
+	var account Account
+	if err := json.Unmarshal(body, &account); err != nil {
+		return nil, err
+	}
+	return &account, nil
+}
+
+// GetPositions 查询持仓，对应 GET /fapi/v2/positionRisk。symbol 为空时返回
+// 所有交易对的持仓。
+func (c *AuthenticatedClient) GetPositions(symbol string) ([]Position, error) {
+	q := url.Values{}
+	if symbol != "" {
+		q.Set("symbol", normalizeBinanceSymbol(symbol))
+	}
+
+	body, err := c.signedRequest(http.MethodGet, "/fapi/v2/positionRisk", q)
+	if err != nil {
+		return nil, err
+	}
+	var positions []Position
+	if err := json.Unmarshal(body, &positions); err != nil {
+		return nil, err
+	}
+	return positions, nil
+}
+
+// SetLeverage 调整杠杆倍数，对应 POST /fapi/v1/leverage。
+func (c *AuthenticatedClient) SetLeverage(symbol string, leverage int) error {
+	q := url.Values{}
+	q.Set("symbol", normalizeBinanceSymbol(symbol))
+	q.Set("leverage", strconv.Itoa(leverage))
+
+	_, err := c.signedRequest(http.MethodPost, "/fapi/v1/leverage", q)
+	return err
+}
+
+// ChangeMarginType 切换逐仓/全仓模式，对应 POST /fapi/v1/marginType。
+func (c *AuthenticatedClient) ChangeMarginType(symbol string, marginType MarginType) error {
+	q := url.Values{}
+	q.Set("symbol", normalizeBinanceSymbol(symbol))
+	q.Set("marginType", string(marginType))
+
+	_, err := c.signedRequest(http.MethodPost, "/fapi/v1/marginType", q)
+	return err
+}
+
+// apiKeyRequest 发起一个只需 X-MBX-APIKEY 而无需签名的请求，listenKey 相关
+// 接口属于此类。
+func (c *AuthenticatedClient) apiKeyRequest(method, path string) ([]byte, error) {
+	req, err := http.NewRequest(method, baseURL+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-MBX-APIKEY", c.apiKey)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("market: binance futures listen key api error (status %d): %s", resp.StatusCode, string(body))
+	}
+	return body, nil
+}
+
+// CreateListenKey 申请一个用户数据流 listenKey，对应 POST /fapi/v1/listenKey，
+// 供 market/stream 包的 SubscribeUserData 使用。listenKey 在 60 分钟内无
+// keep-alive 会被 Binance 自动关闭。
+func (c *AuthenticatedClient) CreateListenKey() (string, error) {
+	body, err := c.apiKeyRequest(http.MethodPost, "/fapi/v1/listenKey")
+	if err != nil {
+		return "", err
+	}
+	var out struct {
+		ListenKey string `json:"listenKey"`
+	}
+	if err := json.Unmarshal(body, &out); err != nil {
+		return "", err
+	}
+	return out.ListenKey, nil
+}
+
+// KeepAliveListenKey 续期 listenKey，对应 PUT /fapi/v1/listenKey，应每 30
+// 分钟调用一次。
+func (c *AuthenticatedClient) KeepAliveListenKey() error {
+	_, err := c.apiKeyRequest(http.MethodPut, "/fapi/v1/listenKey")
+	return err
+}
+
+// CloseListenKey 主动关闭 listenKey，对应 DELETE /fapi/v1/listenKey。
+func (c *AuthenticatedClient) CloseListenKey() error {
+	_, err := c.apiKeyRequest(http.MethodDelete, "/fapi/v1/listenKey")
+	return err
+}
@@ -0,0 +1,61 @@
+package market
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestAuthenticatedClientSign(t *testing.T) {
+	c := NewAuthenticatedClient("test-key", "test-secret")
+
+	q := url.Values{}
+	q.Set("symbol", "BTCUSDT")
+	q.Set("side", "BUY")
+
+	signed := c.sign(q)
+
+	parts := strings.SplitN(signed, "&signature=", 2)
+	if len(parts) != 2 {
+		t.Fatalf("sign() 结果缺少signature段: %q", signed)
+	}
+	payload, gotSignature := parts[0], parts[1]
+
+	mac := hmac.New(sha256.New, []byte("test-secret"))
+	mac.Write([]byte(payload))
+	wantSignature := hex.EncodeToString(mac.Sum(nil))
+	if gotSignature != wantSignature {
+		t.Fatalf("signature = %s, want %s (HMAC-SHA256 over %q with secret test-secret)", gotSignature, wantSignature, payload)
+	}
+
+	values, err := url.ParseQuery(payload)
+	if err != nil {
+		t.Fatalf("解析sign()生成的payload失败: %v", err)
+	}
+	if values.Get("symbol") != "BTCUSDT" || values.Get("side") != "BUY" {
+		t.Fatalf("sign() 丢失了原始参数: %q", payload)
+	}
+	if values.Get("recvWindow") != "5000" {
+		t.Fatalf("recvWindow = %q, want 5000", values.Get("recvWindow"))
+	}
+	if values.Get("timestamp") == "" {
+		t.Fatalf("sign() 未设置timestamp参数")
+	}
+}
+
+func TestAuthenticatedClientSignDifferentSecretsDiffer(t *testing.T) {
+	q1 := url.Values{}
+	q1.Set("symbol", "BTCUSDT")
+	sig1 := strings.SplitN(NewAuthenticatedClient("key", "secret-a").sign(q1), "&signature=", 2)[1]
+
+	q2 := url.Values{}
+	q2.Set("symbol", "BTCUSDT")
+	sig2 := strings.SplitN(NewAuthenticatedClient("key", "secret-b").sign(q2), "&signature=", 2)[1]
+
+	if sig1 == sig2 {
+		t.Fatalf("不同apiSecret不应产生相同签名")
+	}
+}
@@ -0,0 +1,291 @@
+package market
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// baseURL 是 var 而非 const，便于测试时指向 httptest.Server。
+var baseURL = "https://fapi.binance.com"
+
+var (
+	// 全局代理配置
+	globalProxyURL string
+)
+
+// SetProxy 设置全局代理
+func SetProxy(proxyURL string) {
+	globalProxyURL = proxyURL
+	log.Printf("📡 市场数据API代理已设置: %s", proxyURL)
+}
+
+// APIClient 是 Binance USDT-M 合约的 ExchangeProvider 实现。保留此名字是为了
+// 兼容多交易所抽象之前就存在的调用方；新代码应通过
+// market.NewProvider("binance_futures", cfg) 获取实例。
+type APIClient struct {
+	client      *http.Client
+	cache       *KlineCache
+	rateLimiter *rateLimitTransport
+}
+
+// SetKlineCache 为历史 K 线分页接口（GetKlinesRange）挂载一个磁盘缓存，
+// 未设置时每次调用都会直接回源。
+func (c *APIClient) SetKlineCache(cache *KlineCache) {
+	c.cache = cache
+}
+
+func NewAPIClient() *APIClient {
+	return newBinanceFuturesClient(globalProxyURL)
+}
+
+func newBinanceFuturesClient(proxyURL string) *APIClient {
+	transport := &http.Transport{
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: false},
+	}
+
+	// 如果配置了代理，使用代理
+	if proxyURL != "" {
+		proxyURLParsed, err := url.Parse(proxyURL)
+		if err == nil {
+			transport.Proxy = http.ProxyURL(proxyURLParsed)
+			log.Printf("  ✓ HTTP客户端使用代理: %s", proxyURL)
+		} else {
+			log.Printf("  ⚠️  代理URL解析失败: %v", err)
+		}
+	}
+
+	return &APIClient{
+		client: &http.Client{
+			Timeout:   30 * time.Second,
+			Transport: transport,
+		},
+	}
+}
+
+func init() {
+	RegisterProvider("binance_futures", func(cfg ProviderConfig) ExchangeProvider {
+		proxyURL := cfg.ProxyURL
+		if proxyURL == "" {
+			proxyURL = globalProxyURL
+		}
+		return newBinanceFuturesClient(proxyURL)
+	})
+}
+
+// normalizeBinanceSymbol 把任意写法的交易对（"btc-usdt"、"BTC_USDT"、
+// "btc/usdt"）归一化成 Binance 惯用的无分隔符大写形式 "BTCUSDT"。
+func normalizeBinanceSymbol(symbol string) string {
+	replacer := strings.NewReplacer("-", "", "_", "", "/", "")
+	return strings.ToUpper(replacer.Replace(symbol))
+}
+
+func (c *APIClient) GetExchangeInfo() (*ExchangeInfo, error) {
+	url := fmt.Sprintf("%s/fapi/v1/exchangeInfo", baseURL)
+	resp, err := c.client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var exchangeInfo ExchangeInfo
+	err = json.Unmarshal(body, &exchangeInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &exchangeInfo, nil
+}
+
+func (c *APIClient) GetKlines(symbol, interval string, limit int) ([]Kline, error) {
+	symbol = normalizeBinanceSymbol(symbol)
+	url := fmt.Sprintf("%s/fapi/v1/klines", baseURL)
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	q := req.URL.Query()
+	q.Add("symbol", symbol)
+	q.Add("interval", interval)
+	q.Add("limit", strconv.Itoa(limit))
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var klines []Kline
+	if err := json.Unmarshal(body, &klines); err != nil {
+		return nil, fmt.Errorf("解析K线数据失败: %w", err)
+	}
+	if err := ValidateKlineSequence(klines); err != nil {
+		return nil, err
+	}
+
+	return klines, nil
+}
+
+func (c *APIClient) GetCurrentPrice(symbol string) (float64, error) {
+	symbol = normalizeBinanceSymbol(symbol)
+	url := fmt.Sprintf("%s/fapi/v1/ticker/price", baseURL)
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	q := req.URL.Query()
+	q.Add("symbol", symbol)
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, err
+	}
+
+	var ticker PriceTicker
+	err = json.Unmarshal(body, &ticker)
+	if err != nil {
+		return 0, err
+	}
+
+	price, err := strconv.ParseFloat(ticker.Price, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	return price, nil
+}
+
+func (c *APIClient) GetFundingRate(symbol string) (*FundingRate, error) {
+	symbol = normalizeBinanceSymbol(symbol)
+	url := fmt.Sprintf("%s/fapi/v1/premiumIndex", baseURL)
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	q := req.URL.Query()
+	q.Add("symbol", symbol)
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw struct {
+		Symbol          string `json:"symbol"`
+		LastFundingRate string `json:"lastFundingRate"`
+		NextFundingTime int64  `json:"nextFundingTime"`
+	}
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, err
+	}
+
+	rate, err := strconv.ParseFloat(raw.LastFundingRate, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FundingRate{
+		Symbol:      raw.Symbol,
+		FundingRate: rate,
+		FundingTime: raw.NextFundingTime,
+	}, nil
+}
+
+func (c *APIClient) GetDepth(symbol string, limit int) (*Depth, error) {
+	symbol = normalizeBinanceSymbol(symbol)
+	url := fmt.Sprintf("%s/fapi/v1/depth", baseURL)
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	q := req.URL.Query()
+	q.Add("symbol", symbol)
+	q.Add("limit", strconv.Itoa(limit))
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw struct {
+		LastUpdateID int64      `json:"lastUpdateId"`
+		Bids         [][]string `json:"bids"`
+		Asks         [][]string `json:"asks"`
+	}
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, err
+	}
+
+	depth := &Depth{Symbol: symbol, LastUpdateID: raw.LastUpdateID}
+	depth.Bids, err = parseDepthLevels(raw.Bids)
+	if err != nil {
+		return nil, err
+	}
+	depth.Asks, err = parseDepthLevels(raw.Asks)
+	if err != nil {
+		return nil, err
+	}
+
+	return depth, nil
+}
+
+func parseDepthLevels(raw [][]string) ([]DepthLevel, error) {
+	levels := make([]DepthLevel, 0, len(raw))
+	for _, level := range raw {
+		if len(level) < 2 {
+			return nil, fmt.Errorf("invalid depth level: %v", level)
+		}
+		price, err := strconv.ParseFloat(level[0], 64)
+		if err != nil {
+			return nil, err
+		}
+		qty, err := strconv.ParseFloat(level[1], 64)
+		if err != nil {
+			return nil, err
+		}
+		levels = append(levels, DepthLevel{Price: price, Quantity: qty})
+	}
+	return levels, nil
+}
@@ -0,0 +1,166 @@
+package market
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const binanceSpotBaseURL = "https://api.binance.com"
+
+// BinanceSpotProvider 对接 Binance 现货市场数据接口，symbol 规则与合约一致
+// （如 "BTCUSDT"），直接复用 normalizeBinanceSymbol。
+type BinanceSpotProvider struct {
+	client *http.Client
+}
+
+func newBinanceSpotClient(proxyURL string) *BinanceSpotProvider {
+	transport := &http.Transport{
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: false},
+	}
+	if proxyURL != "" {
+		if parsed, err := url.Parse(proxyURL); err == nil {
+			transport.Proxy = http.ProxyURL(parsed)
+		}
+	}
+	return &BinanceSpotProvider{
+		client: &http.Client{Timeout: 30 * time.Second, Transport: transport},
+	}
+}
+
+func init() {
+	RegisterProvider("binance_spot", func(cfg ProviderConfig) ExchangeProvider {
+		proxyURL := cfg.ProxyURL
+		if proxyURL == "" {
+			proxyURL = globalProxyURL
+		}
+		return newBinanceSpotClient(proxyURL)
+	})
+}
+
+func (p *BinanceSpotProvider) GetExchangeInfo() (*ExchangeInfo, error) {
+	resp, err := p.client.Get(fmt.Sprintf("%s/api/v3/exchangeInfo", binanceSpotBaseURL))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var info ExchangeInfo
+	if err := json.Unmarshal(body, &info); err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+func (p *BinanceSpotProvider) GetKlines(symbol, interval string, limit int) ([]Kline, error) {
+	symbol = normalizeBinanceSymbol(symbol)
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/v3/klines", binanceSpotBaseURL), nil)
+	if err != nil {
+		return nil, err
+	}
+	q := req.URL.Query()
+	q.Add("symbol", symbol)
+	q.Add("interval", interval)
+	q.Add("limit", strconv.Itoa(limit))
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var klines []Kline
+	if err := json.Unmarshal(body, &klines); err != nil {
+		return nil, fmt.Errorf("解析K线数据失败: %w", err)
+	}
+	if err := ValidateKlineSequence(klines); err != nil {
+		return nil, err
+	}
+	return klines, nil
+}
+
+func (p *BinanceSpotProvider) GetCurrentPrice(symbol string) (float64, error) {
+	symbol = normalizeBinanceSymbol(symbol)
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/v3/ticker/price", binanceSpotBaseURL), nil)
+	if err != nil {
+		return 0, err
+	}
+	q := req.URL.Query()
+	q.Add("symbol", symbol)
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, err
+	}
+	var ticker PriceTicker
+	if err := json.Unmarshal(body, &ticker); err != nil {
+		return 0, err
+	}
+	return strconv.ParseFloat(ticker.Price, 64)
+}
+
+// GetFundingRate 现货没有资金费率概念。
+func (p *BinanceSpotProvider) GetFundingRate(symbol string) (*FundingRate, error) {
+	return nil, ErrNotSupported
+}
+
+func (p *BinanceSpotProvider) GetDepth(symbol string, limit int) (*Depth, error) {
+	symbol = normalizeBinanceSymbol(symbol)
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/v3/depth", binanceSpotBaseURL), nil)
+	if err != nil {
+		return nil, err
+	}
+	q := req.URL.Query()
+	q.Add("symbol", symbol)
+	q.Add("limit", strconv.Itoa(limit))
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var raw struct {
+		LastUpdateID int64      `json:"lastUpdateId"`
+		Bids         [][]string `json:"bids"`
+		Asks         [][]string `json:"asks"`
+	}
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, err
+	}
+
+	depth := &Depth{Symbol: symbol, LastUpdateID: raw.LastUpdateID}
+	if depth.Bids, err = parseDepthLevels(raw.Bids); err != nil {
+		return nil, err
+	}
+	if depth.Asks, err = parseDepthLevels(raw.Asks); err != nil {
+		return nil, err
+	}
+	return depth, nil
+}
@@ -0,0 +1,255 @@
+package market
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const bybitBaseURL = "https://api.bybit.com"
+
+// BybitProvider 对接 Bybit V5 统一账户接口的 linear（USDT 永续）市场数据。
+// symbol 规则与 Binance 一致（无分隔符大写），直接复用 normalizeBinanceSymbol。
+type BybitProvider struct {
+	client *http.Client
+}
+
+func newBybitClient(proxyURL string) *BybitProvider {
+	transport := &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: false}}
+	if proxyURL != "" {
+		if parsed, err := url.Parse(proxyURL); err == nil {
+			transport.Proxy = http.ProxyURL(parsed)
+		}
+	}
+	return &BybitProvider{client: &http.Client{Timeout: 30 * time.Second, Transport: transport}}
+}
+
+func init() {
+	RegisterProvider("bybit", func(cfg ProviderConfig) ExchangeProvider {
+		proxyURL := cfg.ProxyURL
+		if proxyURL == "" {
+			proxyURL = globalProxyURL
+		}
+		return newBybitClient(proxyURL)
+	})
+}
+
+type bybitResponse struct {
+	RetCode int             `json:"retCode"`
+	RetMsg  string          `json:"retMsg"`
+	Result  json.RawMessage `json:"result"`
+}
+
+func (p *BybitProvider) get(path string, query url.Values) (*bybitResponse, error) {
+	req, err := http.NewRequest("GET", bybitBaseURL+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.URL.RawQuery = query.Encode()
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var out bybitResponse
+	if err := json.Unmarshal(body, &out); err != nil {
+		return nil, err
+	}
+	if out.RetCode != 0 {
+		return nil, fmt.Errorf("bybit: %s", out.RetMsg)
+	}
+	return &out, nil
+}
+
+func (p *BybitProvider) GetExchangeInfo() (*ExchangeInfo, error) {
+	out, err := p.get("/v5/market/instruments-info", url.Values{"category": {"linear"}})
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		List []struct {
+			Symbol    string `json:"symbol"`
+			BaseCoin  string `json:"baseCoin"`
+			QuoteCoin string `json:"quoteCoin"`
+			Status    string `json:"status"`
+		} `json:"list"`
+	}
+	if err := json.Unmarshal(out.Result, &result); err != nil {
+		return nil, err
+	}
+
+	info := &ExchangeInfo{}
+	for _, item := range result.List {
+		info.Symbols = append(info.Symbols, SymbolInfo{
+			Symbol:     item.Symbol,
+			BaseAsset:  item.BaseCoin,
+			QuoteAsset: item.QuoteCoin,
+			Status:     item.Status,
+		})
+	}
+	return info, nil
+}
+
+func (p *BybitProvider) GetKlines(symbol, interval string, limit int) ([]Kline, error) {
+	symbol = normalizeBinanceSymbol(symbol)
+	out, err := p.get("/v5/market/kline", url.Values{
+		"category": {"linear"},
+		"symbol":   {symbol},
+		"interval": {interval},
+		"limit":    {strconv.Itoa(limit)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		List [][]string `json:"list"`
+	}
+	if err := json.Unmarshal(out.Result, &result); err != nil {
+		return nil, err
+	}
+
+	klines := make([]Kline, 0, len(result.List))
+	// Bybit 按时间倒序返回，这里从末尾向前逐条解析，结果即为时间升序。
+	for i := len(result.List) - 1; i >= 0; i-- {
+		row := result.List[i]
+		if len(row) < 7 {
+			return nil, fmt.Errorf("bybit: K线数据字段数不足: %v", row)
+		}
+		openTime, err := strconv.ParseInt(row[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("bybit: K线字段openTime不是整数: %w", err)
+		}
+		open, err := strconv.ParseFloat(row[1], 64)
+		if err != nil {
+			return nil, fmt.Errorf("bybit: K线字段open不是合法数字: %w", err)
+		}
+		high, err := strconv.ParseFloat(row[2], 64)
+		if err != nil {
+			return nil, fmt.Errorf("bybit: K线字段high不是合法数字: %w", err)
+		}
+		low, err := strconv.ParseFloat(row[3], 64)
+		if err != nil {
+			return nil, fmt.Errorf("bybit: K线字段low不是合法数字: %w", err)
+		}
+		closeP, err := strconv.ParseFloat(row[4], 64)
+		if err != nil {
+			return nil, fmt.Errorf("bybit: K线字段close不是合法数字: %w", err)
+		}
+		vol, err := strconv.ParseFloat(row[5], 64)
+		if err != nil {
+			return nil, fmt.Errorf("bybit: K线字段volume不是合法数字: %w", err)
+		}
+		quoteVol, err := strconv.ParseFloat(row[6], 64)
+		if err != nil {
+			return nil, fmt.Errorf("bybit: K线字段quoteVolume不是合法数字: %w", err)
+		}
+		klines = append(klines, Kline{
+			OpenTime:    openTime,
+			Open:        open,
+			High:        high,
+			Low:         low,
+			Close:       closeP,
+			Volume:      vol,
+			QuoteVolume: quoteVol,
+		})
+	}
+	if err := ValidateKlineSequence(klines); err != nil {
+		return nil, err
+	}
+	return klines, nil
+}
+
+func (p *BybitProvider) GetCurrentPrice(symbol string) (float64, error) {
+	symbol = normalizeBinanceSymbol(symbol)
+	out, err := p.get("/v5/market/tickers", url.Values{"category": {"linear"}, "symbol": {symbol}})
+	if err != nil {
+		return 0, err
+	}
+
+	var result struct {
+		List []struct {
+			LastPrice string `json:"lastPrice"`
+		} `json:"list"`
+	}
+	if err := json.Unmarshal(out.Result, &result); err != nil {
+		return 0, err
+	}
+	if len(result.List) == 0 {
+		return 0, fmt.Errorf("bybit: empty ticker response")
+	}
+	return strconv.ParseFloat(result.List[0].LastPrice, 64)
+}
+
+func (p *BybitProvider) GetFundingRate(symbol string) (*FundingRate, error) {
+	symbol = normalizeBinanceSymbol(symbol)
+	out, err := p.get("/v5/market/tickers", url.Values{"category": {"linear"}, "symbol": {symbol}})
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		List []struct {
+			Symbol          string `json:"symbol"`
+			FundingRate     string `json:"fundingRate"`
+			NextFundingTime string `json:"nextFundingTime"`
+		} `json:"list"`
+	}
+	if err := json.Unmarshal(out.Result, &result); err != nil {
+		return nil, err
+	}
+	if len(result.List) == 0 {
+		return nil, fmt.Errorf("bybit: empty ticker response")
+	}
+
+	rate, err := strconv.ParseFloat(result.List[0].FundingRate, 64)
+	if err != nil {
+		return nil, err
+	}
+	nextTime, _ := strconv.ParseInt(result.List[0].NextFundingTime, 10, 64)
+	return &FundingRate{Symbol: result.List[0].Symbol, FundingRate: rate, FundingTime: nextTime}, nil
+}
+
+func (p *BybitProvider) GetDepth(symbol string, limit int) (*Depth, error) {
+	symbol = normalizeBinanceSymbol(symbol)
+	out, err := p.get("/v5/market/orderbook", url.Values{
+		"category": {"linear"},
+		"symbol":   {symbol},
+		"limit":    {strconv.Itoa(limit)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		S string     `json:"s"`
+		B [][]string `json:"b"`
+		A [][]string `json:"a"`
+		U int64      `json:"u"`
+	}
+	if err := json.Unmarshal(out.Result, &result); err != nil {
+		return nil, err
+	}
+
+	depth := &Depth{Symbol: result.S, LastUpdateID: result.U}
+	if depth.Bids, err = parseDepthLevels(result.B); err != nil {
+		return nil, err
+	}
+	if depth.Asks, err = parseDepthLevels(result.A); err != nil {
+		return nil, err
+	}
+	return depth, nil
+}
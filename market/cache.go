@@ -0,0 +1,84 @@
+package market
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// KlineCache 是按 symbol/interval/day 分桶的历史 K 线磁盘缓存，用于避免
+// 回测反复拉取同一段历史数据。每个桶落盘为一个 gzip 压缩的 JSON 数组。
+type KlineCache struct {
+	dir string
+}
+
+// NewKlineCache 创建一个以 dir 为根目录的缓存，dir 不存在时会被创建。
+func NewKlineCache(dir string) (*KlineCache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &KlineCache{dir: dir}, nil
+}
+
+func (c *KlineCache) path(symbol, interval string, day time.Time) string {
+	return filepath.Join(c.dir, symbol, interval, day.UTC().Format("2006-01-02")+".json.gz")
+}
+
+// Load 读取某一天的缓存，ok 为 false 表示缓存未命中（而非错误）。
+func (c *KlineCache) Load(symbol, interval string, day time.Time) (klines []Kline, ok bool, err error) {
+	f, err := os.Open(c.path(symbol, interval, day))
+	if os.IsNotExist(err) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	defer f.Close()
+
+	reader, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, false, fmt.Errorf("market: 读取缓存失败: %w", err)
+	}
+	defer reader.Close()
+
+	body, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if err := json.Unmarshal(body, &klines); err != nil {
+		return nil, false, err
+	}
+	return klines, true, nil
+}
+
+// Store 把某一天的完整 K 线写入缓存，调用方需保证 klines 已覆盖该天全部
+// 区间，否则后续 Load 会返回不完整的数据。
+func (c *KlineCache) Store(symbol, interval string, day time.Time, klines []Kline) error {
+	path := c.path(symbol, interval, day)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(klines)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	writer := gzip.NewWriter(f)
+	if _, err := writer.Write(body); err != nil {
+		writer.Close()
+		return err
+	}
+	return writer.Close()
+}
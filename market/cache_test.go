@@ -0,0 +1,76 @@
+package market
+
+import (
+	"testing"
+	"time"
+)
+
+func TestKlineCacheStoreLoadRoundTrip(t *testing.T) {
+	cache, err := NewKlineCache(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewKlineCache() error = %v", err)
+	}
+
+	day := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	want := []Kline{
+		{OpenTime: 1, CloseTime: 2, Open: 10, High: 12, Low: 9, Close: 11},
+		{OpenTime: 2, CloseTime: 3, Open: 11, High: 13, Low: 10, Close: 12},
+	}
+
+	if err := cache.Store("BTCUSDT", "1h", day, want); err != nil {
+		t.Fatalf("Store() error = %v", err)
+	}
+
+	got, ok, err := cache.Load("BTCUSDT", "1h", day)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if !ok {
+		t.Fatalf("Load() ok = false, want true for a day that was just stored")
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Load() 返回 %d 根K线, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Load()[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestKlineCacheLoadMiss(t *testing.T) {
+	cache, err := NewKlineCache(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewKlineCache() error = %v", err)
+	}
+
+	_, ok, err := cache.Load("BTCUSDT", "1h", time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if ok {
+		t.Fatalf("Load() ok = true, want false for a day that was never stored")
+	}
+}
+
+func TestKlineCacheDaysAreIsolated(t *testing.T) {
+	cache, err := NewKlineCache(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewKlineCache() error = %v", err)
+	}
+
+	day1 := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	day2 := time.Date(2024, 1, 16, 0, 0, 0, 0, time.UTC)
+
+	if err := cache.Store("BTCUSDT", "1h", day1, []Kline{{OpenTime: 1, CloseTime: 2}}); err != nil {
+		t.Fatalf("Store() error = %v", err)
+	}
+
+	_, ok, err := cache.Load("BTCUSDT", "1h", day2)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if ok {
+		t.Fatalf("Load() 不应该命中没有存过的相邻日期")
+	}
+}
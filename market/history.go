@@ -0,0 +1,207 @@
+package market
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// maxKlinesPerRequest 是 Binance 单次 K 线请求允许的最大条数。
+const maxKlinesPerRequest = 1500
+
+// maxConcurrentBackfill 限制回填历史数据时同时在飞的请求数，避免瞬时撞上
+// 权重上限。限速/熔断本身由 WithRateLimit 挂载的 rateLimitTransport 统一
+// 承担，这里不再维护单独的权重估算。
+const maxConcurrentBackfill = 5
+
+const dayMs = 24 * 60 * 60 * 1000
+
+// intervalMillis 列出常用 K 线周期对应的毫秒数，用于切分回填窗口和缓存天
+// 粒度。自然月长度不固定，不在此列，调用 GetKlinesRange 传 "1M" 会返回
+// 错误。
+var intervalMillis = map[string]int64{
+	"1m":  60_000,
+	"3m":  180_000,
+	"5m":  300_000,
+	"15m": 900_000,
+	"30m": 1_800_000,
+	"1h":  3_600_000,
+	"2h":  7_200_000,
+	"4h":  14_400_000,
+	"6h":  21_600_000,
+	"8h":  28_800_000,
+	"12h": 43_200_000,
+	"1d":  86_400_000,
+	"3d":  259_200_000,
+	"1w":  604_800_000,
+}
+
+func intervalToMillis(interval string) (int64, error) {
+	ms, ok := intervalMillis[interval]
+	if !ok {
+		return 0, fmt.Errorf("market: 不支持按时间范围回填的K线周期: %q", interval)
+	}
+	return ms, nil
+}
+
+// GetKlinesRange 按 [startMs, endMs) 分页拉取历史 K 线。内部以自然日为缓存
+// 粒度、以有界 worker pool 并发回填每一天；同一天内若蜡烛数超过 1500（如
+// 极短周期），再按 1500 根一窗继续切分请求。若调用方通过 WithRateLimit 挂载
+// 了限速中间件，这些请求与其他端点共享同一套权重/熔断状态；否则不做额外
+// 限速。返回结果已去重并按时间升序排列。
+func (c *APIClient) GetKlinesRange(symbol, interval string, startMs, endMs int64) ([]Kline, error) {
+	intervalMs, err := intervalToMillis(interval)
+	if err != nil {
+		return nil, err
+	}
+	if endMs <= startMs {
+		return nil, fmt.Errorf("market: 无效的时间范围 [%d, %d]", startMs, endMs)
+	}
+	symbol = normalizeBinanceSymbol(symbol)
+
+	type dayBucket struct {
+		start, end int64 // [start, end]，闭区间，对应一次 GetKlines startTime/endTime
+		full       bool  // 是否整天都落在 [startMs, endMs) 内，只有整天的结果才会写缓存
+	}
+
+	var buckets []dayBucket
+	for ds := startMs - startMs%dayMs; ds < endMs; ds += dayMs {
+		de := ds + dayMs - 1
+		full := ds >= startMs && de < endMs
+		bs, be := ds, de
+		if bs < startMs {
+			bs = startMs
+		}
+		if be >= endMs {
+			be = endMs - 1
+		}
+		buckets = append(buckets, dayBucket{start: bs, end: be, full: full})
+	}
+
+	results := make([][]Kline, len(buckets))
+	errs := make([]error, len(buckets))
+
+	sem := make(chan struct{}, maxConcurrentBackfill)
+	var wg sync.WaitGroup
+	for i, b := range buckets {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, b dayBucket) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			results[i], errs[i] = c.fetchDayBucket(symbol, interval, intervalMs, b.start, b.end, b.full)
+		}(i, b)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	merged := make(map[int64]Kline)
+	for _, batch := range results {
+		for _, k := range batch {
+			merged[k.OpenTime] = k
+		}
+	}
+
+	klines := make([]Kline, 0, len(merged))
+	for _, k := range merged {
+		if k.OpenTime >= startMs && k.OpenTime < endMs {
+			klines = append(klines, k)
+		}
+	}
+	sort.Slice(klines, func(i, j int) bool { return klines[i].OpenTime < klines[j].OpenTime })
+
+	return klines, nil
+}
+
+// fetchDayBucket 拉取一个（可能是部分）自然日的 K 线，完整自然日优先读写
+// 磁盘缓存。
+func (c *APIClient) fetchDayBucket(symbol, interval string, intervalMs, startMs, endMs int64, full bool) ([]Kline, error) {
+	day := time.UnixMilli(startMs - startMs%dayMs).UTC()
+
+	if full && c.cache != nil {
+		if klines, ok, err := c.cache.Load(symbol, interval, day); err != nil {
+			return nil, err
+		} else if ok {
+			return klines, nil
+		}
+	}
+
+	klines, err := c.fetchWindowed(symbol, interval, intervalMs, startMs, endMs)
+	if err != nil {
+		return nil, err
+	}
+
+	if full && c.cache != nil {
+		if err := c.cache.Store(symbol, interval, day, klines); err != nil {
+			return nil, err
+		}
+	}
+	return klines, nil
+}
+
+// fetchWindowed 把 [startMs, endMs] 按 1500 根一窗顺序请求并拼接，单个自然
+// 日桶内通常只需一次请求，仅当周期极短导致单日蜡烛数超过 1500 时才会多次
+// 分页。
+func (c *APIClient) fetchWindowed(symbol, interval string, intervalMs, startMs, endMs int64) ([]Kline, error) {
+	windowMs := intervalMs * maxKlinesPerRequest
+
+	var klines []Kline
+	for ws := startMs; ws <= endMs; ws += windowMs {
+		we := ws + windowMs - 1
+		if we > endMs {
+			we = endMs
+		}
+		batch, err := c.requestKlinesWindow(symbol, interval, ws, we, maxKlinesPerRequest)
+		if err != nil {
+			return nil, err
+		}
+		klines = append(klines, batch...)
+	}
+	return klines, nil
+}
+
+func (c *APIClient) requestKlinesWindow(symbol, interval string, startMs, endMs int64, limit int) ([]Kline, error) {
+	url := fmt.Sprintf("%s/fapi/v1/klines", baseURL)
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	q := req.URL.Query()
+	q.Add("symbol", symbol)
+	q.Add("interval", interval)
+	q.Add("startTime", strconv.FormatInt(startMs, 10))
+	q.Add("endTime", strconv.FormatInt(endMs, 10))
+	q.Add("limit", strconv.Itoa(limit))
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var klines []Kline
+	if err := json.Unmarshal(body, &klines); err != nil {
+		return nil, fmt.Errorf("market: 解析K线数据失败: %w", err)
+	}
+	if err := ValidateKlineSequence(klines); err != nil {
+		return nil, err
+	}
+	return klines, nil
+}
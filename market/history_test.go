@@ -0,0 +1,84 @@
+package market
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+// fakeKlineRow 按 Binance K线数组的字段顺序构造一行，供测试服务端返回。
+func fakeKlineRow(openTime, intervalMs int64) []interface{} {
+	return []interface{}{
+		openTime, "100", "110", "90", "105", "10",
+		openTime + intervalMs - 1, "1000", 5, "5", "500", "0",
+	}
+}
+
+func TestGetKlinesRangeDedupAndOrder(t *testing.T) {
+	const intervalMs = int64(3_600_000) // 1h
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		start, _ := strconv.ParseInt(q.Get("startTime"), 10, 64)
+		end, _ := strconv.ParseInt(q.Get("endTime"), 10, 64)
+
+		var rows [][]interface{}
+		for ts := start; ts <= end; ts += intervalMs {
+			rows = append(rows, fakeKlineRow(ts, intervalMs))
+		}
+
+		body, err := json.Marshal(rows)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Write(body)
+	}))
+	defer server.Close()
+
+	origBaseURL := baseURL
+	baseURL = server.URL
+	defer func() { baseURL = origBaseURL }()
+
+	c := &APIClient{client: server.Client()}
+
+	// 起止时间横跨自然日边界，迫使 GetKlinesRange 拆成两个并发的日 bucket
+	// 再合并，用于验证跨 bucket 的去重/排序逻辑。
+	startMs := dayMs - 2*intervalMs
+	endMs := dayMs + 2*intervalMs
+
+	klines, err := c.GetKlinesRange("BTCUSDT", "1h", startMs, endMs)
+	if err != nil {
+		t.Fatalf("GetKlinesRange() error = %v", err)
+	}
+
+	wantCount := int((endMs - startMs) / intervalMs)
+	if len(klines) != wantCount {
+		t.Fatalf("GetKlinesRange() 返回 %d 根K线, want %d: %+v", len(klines), wantCount, klines)
+	}
+
+	for i, k := range klines {
+		if k.OpenTime < startMs || k.OpenTime >= endMs {
+			t.Fatalf("klines[%d].OpenTime = %d 超出请求范围 [%d, %d)", i, k.OpenTime, startMs, endMs)
+		}
+		if i > 0 && k.OpenTime <= klines[i-1].OpenTime {
+			t.Fatalf("klines 未按时间严格升序排列: klines[%d].OpenTime=%d <= klines[%d].OpenTime=%d", i, k.OpenTime, i-1, klines[i-1].OpenTime)
+		}
+	}
+}
+
+func TestGetKlinesRangeRejectsInvalidRange(t *testing.T) {
+	c := &APIClient{client: http.DefaultClient}
+	if _, err := c.GetKlinesRange("BTCUSDT", "1h", 100, 100); err == nil {
+		t.Fatalf("GetKlinesRange() 对 endMs<=startMs 应返回错误")
+	}
+}
+
+func TestGetKlinesRangeRejectsUnsupportedInterval(t *testing.T) {
+	c := &APIClient{client: http.DefaultClient}
+	if _, err := c.GetKlinesRange("BTCUSDT", "1M", 0, 1000); err == nil {
+		t.Fatalf("GetKlinesRange() 对不支持的周期应返回错误")
+	}
+}
@@ -0,0 +1,181 @@
+package market
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// klineFields 按顺序列出 Binance K 线数组第 1~5 位（紧随 openTime）的价格/
+// 成交量字段名；UnmarshalJSON 按此顺序逐个解码，并用字段名拼接报错信息。
+var klineFields = [...]string{
+	"open", "high", "low", "close", "volume",
+}
+
+// UnmarshalJSON 直接按 Binance K 线数组的位置顺序解析：
+// [openTime, open, high, low, close, volume, closeTime, quoteVolume,
+//
+//	trades, takerBuyBaseVolume, takerBuyQuoteVolume, ignore]。
+//
+// 用 json.Decoder 按 token 逐个读取，不经过 interface{} 装箱，每个字段的
+// 类型/格式错误都会被单独报告，而不是像之前那样吞掉 ParseFloat 的错误、
+// 产出一根全零的“幽灵蜡烛”。
+func (k *Kline) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	tok, err := dec.Token()
+	if err != nil {
+		return fmt.Errorf("market: 解析K线数组失败: %w", err)
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("market: K线数据不是JSON数组")
+	}
+
+	decodeInt := func(field string) (int64, error) {
+		var num json.Number
+		if err := dec.Decode(&num); err != nil {
+			return 0, fmt.Errorf("market: 缺少或无法解析K线字段 %s: %w", field, err)
+		}
+		v, err := num.Int64()
+		if err != nil {
+			return 0, fmt.Errorf("market: K线字段 %s 不是整数: %w", field, err)
+		}
+		return v, nil
+	}
+
+	decodeFloatString := func(field string) (float64, error) {
+		var s string
+		if err := dec.Decode(&s); err != nil {
+			return 0, fmt.Errorf("market: 缺少或无法解析K线字段 %s: %w", field, err)
+		}
+		v, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return 0, fmt.Errorf("market: K线字段 %s 不是合法数字: %w", field, err)
+		}
+		return v, nil
+	}
+
+	openTime, err := decodeInt("openTime")
+	if err != nil {
+		return err
+	}
+
+	values := make([]float64, len(klineFields))
+	for i, field := range klineFields {
+		v, err := decodeFloatString(field)
+		if err != nil {
+			return err
+		}
+		values[i] = v
+	}
+
+	closeTime, err := decodeInt("closeTime")
+	if err != nil {
+		return err
+	}
+	quoteVolume, err := decodeFloatString("quoteVolume")
+	if err != nil {
+		return err
+	}
+	trades, err := decodeInt("trades")
+	if err != nil {
+		return err
+	}
+	takerBuyBaseVolume, err := decodeFloatString("takerBuyBaseVolume")
+	if err != nil {
+		return err
+	}
+	takerBuyQuoteVolume, err := decodeFloatString("takerBuyQuoteVolume")
+	if err != nil {
+		return err
+	}
+
+	// 之后的字段（如 Binance 的 "ignore" 占位符）直接消费掉，不关心内容；
+	// 数组提前结束（元素数 < 11）会在上面任意一次 Decode 时就已经返回错误。
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return fmt.Errorf("market: 解析K线数组失败: %w", err)
+		}
+		if delim, ok := tok.(json.Delim); ok && delim == ']' {
+			break
+		}
+	}
+
+	*k = Kline{
+		OpenTime:            openTime,
+		Open:                values[0],
+		High:                values[1],
+		Low:                 values[2],
+		Close:               values[3],
+		Volume:              values[4],
+		CloseTime:           closeTime,
+		QuoteVolume:         quoteVolume,
+		Trades:              int(trades),
+		TakerBuyBaseVolume:  takerBuyBaseVolume,
+		TakerBuyQuoteVolume: takerBuyQuoteVolume,
+	}
+	return nil
+}
+
+// MarshalJSON 与 UnmarshalJSON 对称，按相同的字段顺序写回 Binance K线数组
+// 格式，使 Kline 可以直接 json.Marshal/Unmarshal 往返（例如 KlineCache 落盘
+// 读回），而不需要额外的中间表示。
+func (k Kline) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]interface{}{
+		k.OpenTime,
+		strconv.FormatFloat(k.Open, 'f', -1, 64),
+		strconv.FormatFloat(k.High, 'f', -1, 64),
+		strconv.FormatFloat(k.Low, 'f', -1, 64),
+		strconv.FormatFloat(k.Close, 'f', -1, 64),
+		strconv.FormatFloat(k.Volume, 'f', -1, 64),
+		k.CloseTime,
+		strconv.FormatFloat(k.QuoteVolume, 'f', -1, 64),
+		k.Trades,
+		strconv.FormatFloat(k.TakerBuyBaseVolume, 'f', -1, 64),
+		strconv.FormatFloat(k.TakerBuyQuoteVolume, 'f', -1, 64),
+		"0",
+	})
+}
+
+// Validate 校验单根K线内部的一致性：高低点必须包住开收盘价，收盘时间必须
+// 晚于开盘时间。
+func (k Kline) Validate() error {
+	maxOC := k.Open
+	if k.Close > maxOC {
+		maxOC = k.Close
+	}
+	if k.High < maxOC {
+		return fmt.Errorf("market: K线(openTime=%d)最高价 %.8f 低于开/收盘价 %.8f", k.OpenTime, k.High, maxOC)
+	}
+
+	minOC := k.Open
+	if k.Close < minOC {
+		minOC = k.Close
+	}
+	if k.Low > minOC {
+		return fmt.Errorf("market: K线(openTime=%d)最低价 %.8f 高于开/收盘价 %.8f", k.OpenTime, k.Low, minOC)
+	}
+
+	if k.CloseTime <= k.OpenTime {
+		return fmt.Errorf("market: K线(openTime=%d)收盘时间 %d 未晚于开盘时间", k.OpenTime, k.CloseTime)
+	}
+
+	return nil
+}
+
+// ValidateKlineSequence 在逐根 Validate 的基础上，额外校验整个序列按
+// OpenTime 严格递增（既不重复也不乱序），用于在回填/回测前捕获拼接错误。
+func ValidateKlineSequence(klines []Kline) error {
+	for i, k := range klines {
+		if err := k.Validate(); err != nil {
+			return err
+		}
+		if i > 0 && k.OpenTime <= klines[i-1].OpenTime {
+			return fmt.Errorf("market: K线序列在下标 %d 处非严格递增: openTime %d <= %d", i, k.OpenTime, klines[i-1].OpenTime)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package market
+
+import "testing"
+
+func TestKlineUnmarshalJSON(t *testing.T) {
+	raw := []byte(`[
+		1609459200000, "29000.10", "29500.00", "28800.50", "29300.20", "123.456",
+		1609459259999, "3614200.55", 321, "60.111", "1750300.22", "0"
+	]`)
+
+	var k Kline
+	if err := k.UnmarshalJSON(raw); err != nil {
+		t.Fatalf("UnmarshalJSON() error = %v", err)
+	}
+
+	if k.OpenTime != 1609459200000 || k.CloseTime != 1609459259999 {
+		t.Fatalf("OpenTime/CloseTime解析错误: %+v", k)
+	}
+	if k.Open != 29000.10 || k.High != 29500.00 || k.Low != 28800.50 || k.Close != 29300.20 {
+		t.Fatalf("OHLC解析错误: %+v", k)
+	}
+	if k.Trades != 321 {
+		t.Fatalf("Trades解析错误: %+v", k)
+	}
+}
+
+func TestKlineUnmarshalJSON_ShortArrayErrors(t *testing.T) {
+	// 元素数不足11个，应在任意一次Decode时报错，而不是产出一根全零的幽灵蜡烛。
+	raw := []byte(`[1609459200000, "29000.10", "29500.00"]`)
+
+	var k Kline
+	if err := k.UnmarshalJSON(raw); err == nil {
+		t.Fatalf("UnmarshalJSON() 对提前结束的数组应返回错误")
+	}
+}
+
+func TestKlineUnmarshalJSON_BadNumberErrors(t *testing.T) {
+	raw := []byte(`[
+		1609459200000, "not-a-number", "29500.00", "28800.50", "29300.20", "123.456",
+		1609459259999, "3614200.55", 321, "60.111", "1750300.22", "0"
+	]`)
+
+	var k Kline
+	if err := k.UnmarshalJSON(raw); err == nil {
+		t.Fatalf("UnmarshalJSON() 对非法数字字段应返回错误")
+	}
+}
+
+func TestKlineValidate(t *testing.T) {
+	valid := Kline{OpenTime: 1, CloseTime: 2, Open: 10, High: 12, Low: 9, Close: 11}
+	if err := valid.Validate(); err != nil {
+		t.Fatalf("Validate() 对合法K线返回了错误: %v", err)
+	}
+
+	badHigh := valid
+	badHigh.High = 10.5 // 低于收盘价11
+	if err := badHigh.Validate(); err == nil {
+		t.Fatalf("Validate() 应拒绝最高价低于开/收盘价的K线")
+	}
+
+	badLow := valid
+	badLow.Low = 10.5 // 高于开盘价10
+	if err := badLow.Validate(); err == nil {
+		t.Fatalf("Validate() 应拒绝最低价高于开/收盘价的K线")
+	}
+
+	badTime := valid
+	badTime.CloseTime = badTime.OpenTime
+	if err := badTime.Validate(); err == nil {
+		t.Fatalf("Validate() 应拒绝收盘时间未晚于开盘时间的K线")
+	}
+}
+
+func TestValidateKlineSequence(t *testing.T) {
+	seq := []Kline{
+		{OpenTime: 1, CloseTime: 2, Open: 10, High: 12, Low: 9, Close: 11},
+		{OpenTime: 2, CloseTime: 3, Open: 11, High: 13, Low: 10, Close: 12},
+	}
+	if err := ValidateKlineSequence(seq); err != nil {
+		t.Fatalf("ValidateKlineSequence() 对严格递增序列返回了错误: %v", err)
+	}
+
+	outOfOrder := []Kline{seq[1], seq[0]}
+	if err := ValidateKlineSequence(outOfOrder); err == nil {
+		t.Fatalf("ValidateKlineSequence() 应拒绝非严格递增的序列")
+	}
+
+	duplicate := []Kline{seq[0], seq[0]}
+	if err := ValidateKlineSequence(duplicate); err == nil {
+		t.Fatalf("ValidateKlineSequence() 应拒绝重复OpenTime的序列")
+	}
+}
@@ -0,0 +1,234 @@
+package market
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const kucoinBaseURL = "https://api.kucoin.com"
+
+// normalizeKucoinSymbol 把 "BTCUSDT" 这类无分隔符 symbol 转成 Kucoin 现货
+// 要求的 "BTC-USDT" 形式，直接复用 OKX 的切分逻辑，Kucoin 现货 symbol 本身
+// 就是 "BTC-USDT" 这种写法。
+func normalizeKucoinSymbol(symbol string) string {
+	return normalizeOKXSymbol(symbol) // 复用 "切出 quote 资产再加 -" 的逻辑
+}
+
+// KucoinProvider 对接 Kucoin 现货市场数据接口。
+type KucoinProvider struct {
+	client *http.Client
+}
+
+func newKucoinClient(proxyURL string) *KucoinProvider {
+	transport := &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: false}}
+	if proxyURL != "" {
+		if parsed, err := url.Parse(proxyURL); err == nil {
+			transport.Proxy = http.ProxyURL(parsed)
+		}
+	}
+	return &KucoinProvider{client: &http.Client{Timeout: 30 * time.Second, Transport: transport}}
+}
+
+func init() {
+	RegisterProvider("kucoin", func(cfg ProviderConfig) ExchangeProvider {
+		proxyURL := cfg.ProxyURL
+		if proxyURL == "" {
+			proxyURL = globalProxyURL
+		}
+		return newKucoinClient(proxyURL)
+	})
+}
+
+type kucoinResponse struct {
+	Code string          `json:"code"`
+	Data json.RawMessage `json:"data"`
+}
+
+func (p *KucoinProvider) get(path string, query url.Values) (*kucoinResponse, error) {
+	req, err := http.NewRequest("GET", kucoinBaseURL+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.URL.RawQuery = query.Encode()
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var out kucoinResponse
+	if err := json.Unmarshal(body, &out); err != nil {
+		return nil, err
+	}
+	if out.Code != "200000" {
+		return nil, fmt.Errorf("kucoin: error code %s", out.Code)
+	}
+	return &out, nil
+}
+
+func (p *KucoinProvider) GetExchangeInfo() (*ExchangeInfo, error) {
+	out, err := p.get("/api/v1/symbols", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var list []struct {
+		Symbol        string `json:"symbol"`
+		BaseCcy       string `json:"baseCurrency"`
+		QuoteCcy      string `json:"quoteCurrency"`
+		EnableTrading bool   `json:"enableTrading"`
+	}
+	if err := json.Unmarshal(out.Data, &list); err != nil {
+		return nil, err
+	}
+
+	info := &ExchangeInfo{}
+	for _, item := range list {
+		status := "TRADING"
+		if !item.EnableTrading {
+			status = "BREAK"
+		}
+		info.Symbols = append(info.Symbols, SymbolInfo{
+			Symbol:     item.Symbol,
+			BaseAsset:  item.BaseCcy,
+			QuoteAsset: item.QuoteCcy,
+			Status:     status,
+		})
+	}
+	return info, nil
+}
+
+func (p *KucoinProvider) GetKlines(symbol, interval string, limit int) ([]Kline, error) {
+	out, err := p.get("/api/v1/market/candles", url.Values{
+		"symbol": {normalizeKucoinSymbol(symbol)},
+		"type":   {interval},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var rows [][]string
+	if err := json.Unmarshal(out.Data, &rows); err != nil {
+		return nil, err
+	}
+
+	// Kucoin 按时间倒序返回 [time, open, close, high, low, volume, turnover]，
+	// 先截取最新的 limit 条（即切片最前面的 limit 行），再反转为升序，
+	// 这样返回的是最近 limit 根K线，而不是最早的 limit 根。
+	if limit > 0 && len(rows) > limit {
+		rows = rows[:limit]
+	}
+
+	klines := make([]Kline, 0, len(rows))
+	for i := len(rows) - 1; i >= 0; i-- {
+		row := rows[i]
+		if len(row) < 7 {
+			return nil, fmt.Errorf("kucoin: K线数据字段数不足: %v", row)
+		}
+		openTime, err := strconv.ParseInt(row[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("kucoin: K线字段openTime不是整数: %w", err)
+		}
+		open, err := strconv.ParseFloat(row[1], 64)
+		if err != nil {
+			return nil, fmt.Errorf("kucoin: K线字段open不是合法数字: %w", err)
+		}
+		closeP, err := strconv.ParseFloat(row[2], 64)
+		if err != nil {
+			return nil, fmt.Errorf("kucoin: K线字段close不是合法数字: %w", err)
+		}
+		high, err := strconv.ParseFloat(row[3], 64)
+		if err != nil {
+			return nil, fmt.Errorf("kucoin: K线字段high不是合法数字: %w", err)
+		}
+		low, err := strconv.ParseFloat(row[4], 64)
+		if err != nil {
+			return nil, fmt.Errorf("kucoin: K线字段low不是合法数字: %w", err)
+		}
+		vol, err := strconv.ParseFloat(row[5], 64)
+		if err != nil {
+			return nil, fmt.Errorf("kucoin: K线字段volume不是合法数字: %w", err)
+		}
+		turnover, err := strconv.ParseFloat(row[6], 64)
+		if err != nil {
+			return nil, fmt.Errorf("kucoin: K线字段turnover不是合法数字: %w", err)
+		}
+		klines = append(klines, Kline{
+			OpenTime:    openTime * 1000,
+			Open:        open,
+			High:        high,
+			Low:         low,
+			Close:       closeP,
+			Volume:      vol,
+			QuoteVolume: turnover,
+		})
+	}
+	if err := ValidateKlineSequence(klines); err != nil {
+		return nil, err
+	}
+	return klines, nil
+}
+
+func (p *KucoinProvider) GetCurrentPrice(symbol string) (float64, error) {
+	out, err := p.get("/api/v1/market/orderbook/level1", url.Values{"symbol": {normalizeKucoinSymbol(symbol)}})
+	if err != nil {
+		return 0, err
+	}
+	var ticker struct {
+		Price string `json:"price"`
+	}
+	if err := json.Unmarshal(out.Data, &ticker); err != nil {
+		return 0, err
+	}
+	return strconv.ParseFloat(ticker.Price, 64)
+}
+
+// GetFundingRate 现货没有资金费率概念，Kucoin 期货接口走独立域名，未纳入本
+// Provider。
+func (p *KucoinProvider) GetFundingRate(symbol string) (*FundingRate, error) {
+	return nil, ErrNotSupported
+}
+
+func (p *KucoinProvider) GetDepth(symbol string, limit int) (*Depth, error) {
+	out, err := p.get("/api/v1/market/orderbook/level2_20", url.Values{"symbol": {normalizeKucoinSymbol(symbol)}})
+	if err != nil {
+		return nil, err
+	}
+
+	var raw struct {
+		Sequence string     `json:"sequence"`
+		Bids     [][]string `json:"bids"`
+		Asks     [][]string `json:"asks"`
+	}
+	if err := json.Unmarshal(out.Data, &raw); err != nil {
+		return nil, err
+	}
+
+	seq, _ := strconv.ParseInt(raw.Sequence, 10, 64)
+	depth := &Depth{Symbol: normalizeKucoinSymbol(symbol), LastUpdateID: seq}
+	if depth.Bids, err = parseDepthLevels(raw.Bids); err != nil {
+		return nil, err
+	}
+	if depth.Asks, err = parseDepthLevels(raw.Asks); err != nil {
+		return nil, err
+	}
+	if limit > 0 && len(depth.Bids) > limit {
+		depth.Bids = depth.Bids[:limit]
+	}
+	if limit > 0 && len(depth.Asks) > limit {
+		depth.Asks = depth.Asks[:limit]
+	}
+	return depth, nil
+}
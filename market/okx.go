@@ -0,0 +1,254 @@
+package market
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const okxBaseURL = "https://www.okx.com"
+
+// okxQuoteAssets 按长度从长到短排列，用于把无分隔符的 symbol（如
+// "BTCUSDT"）切分成 OKX 要求的 "BTC-USDT" 形式。
+var okxQuoteAssets = []string{"USDT", "USDC", "BUSD", "BTC", "ETH", "USD"}
+
+// normalizeOKXSymbol 把 "BTCUSDT"/"btc-usdt"/"btc_usdt" 统一转换成 OKX 的
+// instId 格式 "BTC-USDT"。
+func normalizeOKXSymbol(symbol string) string {
+	symbol = strings.ToUpper(symbol)
+	symbol = strings.NewReplacer("_", "-", "/", "-").Replace(symbol)
+	if strings.Contains(symbol, "-") {
+		return symbol
+	}
+	for _, quote := range okxQuoteAssets {
+		if strings.HasSuffix(symbol, quote) && len(symbol) > len(quote) {
+			return symbol[:len(symbol)-len(quote)] + "-" + quote
+		}
+	}
+	return symbol
+}
+
+// OKXProvider 对接 OKX V5 统一账户/市场数据接口。
+type OKXProvider struct {
+	client *http.Client
+}
+
+func newOKXClient(proxyURL string) *OKXProvider {
+	transport := &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: false}}
+	if proxyURL != "" {
+		if parsed, err := url.Parse(proxyURL); err == nil {
+			transport.Proxy = http.ProxyURL(parsed)
+		}
+	}
+	return &OKXProvider{client: &http.Client{Timeout: 30 * time.Second, Transport: transport}}
+}
+
+func init() {
+	RegisterProvider("okx", func(cfg ProviderConfig) ExchangeProvider {
+		proxyURL := cfg.ProxyURL
+		if proxyURL == "" {
+			proxyURL = globalProxyURL
+		}
+		return newOKXClient(proxyURL)
+	})
+}
+
+type okxResponse struct {
+	Code string            `json:"code"`
+	Msg  string            `json:"msg"`
+	Data []json.RawMessage `json:"data"`
+}
+
+func (p *OKXProvider) get(path string, query url.Values) (*okxResponse, error) {
+	req, err := http.NewRequest("GET", okxBaseURL+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.URL.RawQuery = query.Encode()
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var out okxResponse
+	if err := json.Unmarshal(body, &out); err != nil {
+		return nil, err
+	}
+	if out.Code != "0" {
+		return nil, fmt.Errorf("okx: %s", out.Msg)
+	}
+	return &out, nil
+}
+
+func (p *OKXProvider) GetExchangeInfo() (*ExchangeInfo, error) {
+	out, err := p.get("/api/v5/public/instruments", url.Values{"instType": {"SPOT"}})
+	if err != nil {
+		return nil, err
+	}
+
+	info := &ExchangeInfo{}
+	for _, raw := range out.Data {
+		var inst struct {
+			InstID   string `json:"instId"`
+			BaseCcy  string `json:"baseCcy"`
+			QuoteCcy string `json:"quoteCcy"`
+			State    string `json:"state"`
+		}
+		if err := json.Unmarshal(raw, &inst); err != nil {
+			return nil, err
+		}
+		info.Symbols = append(info.Symbols, SymbolInfo{
+			Symbol:     inst.InstID,
+			BaseAsset:  inst.BaseCcy,
+			QuoteAsset: inst.QuoteCcy,
+			Status:     inst.State,
+		})
+	}
+	return info, nil
+}
+
+func (p *OKXProvider) GetKlines(symbol, interval string, limit int) ([]Kline, error) {
+	out, err := p.get("/api/v5/market/candles", url.Values{
+		"instId": {normalizeOKXSymbol(symbol)},
+		"bar":    {interval},
+		"limit":  {strconv.Itoa(limit)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	klines := make([]Kline, 0, len(out.Data))
+	// OKX 按时间倒序返回，这里从末尾向前逐条解析，结果即为时间升序。
+	for i := len(out.Data) - 1; i >= 0; i-- {
+		raw := out.Data[i]
+		var row []string
+		if err := json.Unmarshal(raw, &row); err != nil {
+			return nil, err
+		}
+		if len(row) < 6 {
+			return nil, fmt.Errorf("okx: K线数据字段数不足: %v", row)
+		}
+		openTime, err := strconv.ParseInt(row[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("okx: K线字段openTime不是整数: %w", err)
+		}
+		open, err := strconv.ParseFloat(row[1], 64)
+		if err != nil {
+			return nil, fmt.Errorf("okx: K线字段open不是合法数字: %w", err)
+		}
+		high, err := strconv.ParseFloat(row[2], 64)
+		if err != nil {
+			return nil, fmt.Errorf("okx: K线字段high不是合法数字: %w", err)
+		}
+		low, err := strconv.ParseFloat(row[3], 64)
+		if err != nil {
+			return nil, fmt.Errorf("okx: K线字段low不是合法数字: %w", err)
+		}
+		closeP, err := strconv.ParseFloat(row[4], 64)
+		if err != nil {
+			return nil, fmt.Errorf("okx: K线字段close不是合法数字: %w", err)
+		}
+		vol, err := strconv.ParseFloat(row[5], 64)
+		if err != nil {
+			return nil, fmt.Errorf("okx: K线字段volume不是合法数字: %w", err)
+		}
+		klines = append(klines, Kline{
+			OpenTime: openTime,
+			Open:     open,
+			High:     high,
+			Low:      low,
+			Close:    closeP,
+			Volume:   vol,
+		})
+	}
+	if err := ValidateKlineSequence(klines); err != nil {
+		return nil, err
+	}
+	return klines, nil
+}
+
+func (p *OKXProvider) GetCurrentPrice(symbol string) (float64, error) {
+	out, err := p.get("/api/v5/market/ticker", url.Values{"instId": {normalizeOKXSymbol(symbol)}})
+	if err != nil {
+		return 0, err
+	}
+	if len(out.Data) == 0 {
+		return 0, fmt.Errorf("okx: empty ticker response")
+	}
+	var ticker struct {
+		Last string `json:"last"`
+	}
+	if err := json.Unmarshal(out.Data[0], &ticker); err != nil {
+		return 0, err
+	}
+	return strconv.ParseFloat(ticker.Last, 64)
+}
+
+func (p *OKXProvider) GetFundingRate(symbol string) (*FundingRate, error) {
+	instID := normalizeOKXSymbol(symbol) + "-SWAP"
+	out, err := p.get("/api/v5/public/funding-rate", url.Values{"instId": {instID}})
+	if err != nil {
+		return nil, err
+	}
+	if len(out.Data) == 0 {
+		return nil, fmt.Errorf("okx: empty funding rate response")
+	}
+	var raw struct {
+		InstID          string `json:"instId"`
+		FundingRate     string `json:"fundingRate"`
+		NextFundingTime string `json:"nextFundingTime"`
+	}
+	if err := json.Unmarshal(out.Data[0], &raw); err != nil {
+		return nil, err
+	}
+	rate, err := strconv.ParseFloat(raw.FundingRate, 64)
+	if err != nil {
+		return nil, err
+	}
+	nextTime, _ := strconv.ParseInt(raw.NextFundingTime, 10, 64)
+	return &FundingRate{Symbol: raw.InstID, FundingRate: rate, FundingTime: nextTime}, nil
+}
+
+func (p *OKXProvider) GetDepth(symbol string, limit int) (*Depth, error) {
+	out, err := p.get("/api/v5/market/books", url.Values{
+		"instId": {normalizeOKXSymbol(symbol)},
+		"sz":     {strconv.Itoa(limit)},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(out.Data) == 0 {
+		return nil, fmt.Errorf("okx: empty depth response")
+	}
+
+	var raw struct {
+		Bids [][]string `json:"bids"`
+		Asks [][]string `json:"asks"`
+		Ts   string     `json:"ts"`
+	}
+	if err := json.Unmarshal(out.Data[0], &raw); err != nil {
+		return nil, err
+	}
+
+	depth := &Depth{Symbol: normalizeOKXSymbol(symbol)}
+	if depth.Bids, err = parseDepthLevels(raw.Bids); err != nil {
+		return nil, err
+	}
+	if depth.Asks, err = parseDepthLevels(raw.Asks); err != nil {
+		return nil, err
+	}
+	return depth, nil
+}
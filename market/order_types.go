@@ -0,0 +1,108 @@
+package market
+
+// OrderSide 是订单方向。
+type OrderSide string
+
+const (
+	OrderSideBuy  OrderSide = "BUY"
+	OrderSideSell OrderSide = "SELL"
+)
+
+// OrderType 对应 Binance USDT-M 合约支持的订单类型。
+type OrderType string
+
+const (
+	OrderTypeLimit        OrderType = "LIMIT"
+	OrderTypeMarket       OrderType = "MARKET"
+	OrderTypeStop         OrderType = "STOP"
+	OrderTypeTakeProfit   OrderType = "TAKE_PROFIT"
+	OrderTypeTrailingStop OrderType = "TRAILING_STOP_MARKET"
+)
+
+// TimeInForce 控制订单的有效方式，LIMIT 类订单必填。
+type TimeInForce string
+
+const (
+	TimeInForceGTC TimeInForce = "GTC"
+	TimeInForceIOC TimeInForce = "IOC"
+	TimeInForceFOK TimeInForce = "FOK"
+)
+
+// PositionSide 用于双向持仓模式下区分多空仓位，单向持仓模式下传 "BOTH"。
+type PositionSide string
+
+const (
+	PositionSideBoth  PositionSide = "BOTH"
+	PositionSideLong  PositionSide = "LONG"
+	PositionSideShort PositionSide = "SHORT"
+)
+
+// MarginType 对应 Binance 的逐仓/全仓模式。
+type MarginType string
+
+const (
+	MarginTypeIsolated MarginType = "ISOLATED"
+	MarginTypeCrossed  MarginType = "CROSSED"
+)
+
+// OrderRequest 描述一次下单请求，字段按 Binance /fapi/v1/order 的参数命名，
+// 非必填字段使用零值表示不传。
+type OrderRequest struct {
+	Symbol        string
+	Side          OrderSide
+	PositionSide  PositionSide
+	Type          OrderType
+	Quantity      float64
+	Price         float64
+	StopPrice     float64
+	CallbackRate  float64 // TRAILING_STOP_MARKET 专用，百分比，如 1.0 表示 1%
+	TimeInForce   TimeInForce
+	ReduceOnly    bool
+	ClientOrderID string
+}
+
+// Order 是下单/查询返回的订单信息。
+type Order struct {
+	OrderID       int64        `json:"orderId"`
+	Symbol        string       `json:"symbol"`
+	Status        string       `json:"status"`
+	ClientOrderID string       `json:"clientOrderId"`
+	Price         string       `json:"price"`
+	AvgPrice      string       `json:"avgPrice"`
+	OrigQty       string       `json:"origQty"`
+	ExecutedQty   string       `json:"executedQty"`
+	Side          OrderSide    `json:"side"`
+	PositionSide  PositionSide `json:"positionSide"`
+	Type          OrderType    `json:"type"`
+	TimeInForce   TimeInForce  `json:"timeInForce"`
+	ReduceOnly    bool         `json:"reduceOnly"`
+	UpdateTime    int64        `json:"updateTime"`
+}
+
+// Account 是合约账户资产信息。
+type Account struct {
+	TotalWalletBalance    string         `json:"totalWalletBalance"`
+	TotalUnrealizedProfit string         `json:"totalUnrealizedProfit"`
+	TotalMarginBalance    string         `json:"totalMarginBalance"`
+	AvailableBalance      string         `json:"availableBalance"`
+	Assets                []AccountAsset `json:"assets"`
+}
+
+// AccountAsset 是账户中单个资产的明细。
+type AccountAsset struct {
+	Asset            string `json:"asset"`
+	WalletBalance    string `json:"walletBalance"`
+	UnrealizedProfit string `json:"unrealizedProfit"`
+	AvailableBalance string `json:"availableBalance"`
+}
+
+// Position 是单个交易对的持仓信息。
+type Position struct {
+	Symbol           string       `json:"symbol"`
+	PositionSide     PositionSide `json:"positionSide"`
+	PositionAmt      string       `json:"positionAmt"`
+	EntryPrice       string       `json:"entryPrice"`
+	UnrealizedProfit string       `json:"unRealizedProfit"`
+	Leverage         string       `json:"leverage"`
+	MarginType       MarginType   `json:"marginType"`
+}
@@ -0,0 +1,50 @@
+package market
+
+import "fmt"
+
+// ExchangeProvider 是所有交易所适配器的统一接口，策略层、回测引擎只依赖此
+// 接口，不关心背后对接的是 Binance、OKX、Bybit 还是 Kucoin。
+type ExchangeProvider interface {
+	// GetExchangeInfo 返回交易所支持的交易对信息。
+	GetExchangeInfo() (*ExchangeInfo, error)
+	// GetKlines 返回最近 limit 根 K 线。
+	GetKlines(symbol, interval string, limit int) ([]Kline, error)
+	// GetCurrentPrice 返回 symbol 的最新成交价。
+	GetCurrentPrice(symbol string) (float64, error)
+	// GetFundingRate 返回合约资金费率，现货 Provider 应返回 ErrNotSupported。
+	GetFundingRate(symbol string) (*FundingRate, error)
+	// GetDepth 返回订单簿深度。
+	GetDepth(symbol string, limit int) (*Depth, error)
+}
+
+// ErrNotSupported 用于现货等不支持资金费率/某些能力的 Provider。
+var ErrNotSupported = fmt.Errorf("market: operation not supported by this provider")
+
+// ProviderConfig 是构造 Provider 所需的通用配置，字段对所有交易所通用，
+// 具体 Provider 按需使用其中的子集。
+type ProviderConfig struct {
+	APIKey    string
+	APISecret string
+	ProxyURL  string
+}
+
+// ProviderFactory 根据配置构造一个 ExchangeProvider 实例。
+type ProviderFactory func(cfg ProviderConfig) ExchangeProvider
+
+var providerRegistry = map[string]ProviderFactory{}
+
+// RegisterProvider 注册一个交易所适配器工厂，供 NewProvider 按名称查找。
+// 各 Provider 实现应在其 init() 中调用本函数完成自注册。
+func RegisterProvider(name string, factory ProviderFactory) {
+	providerRegistry[name] = factory
+}
+
+// NewProvider 按名称构造一个 ExchangeProvider，name 取值例如
+// "binance_futures"、"binance_spot"、"okx"、"bybit"、"kucoin"。
+func NewProvider(name string, cfg ProviderConfig) (ExchangeProvider, error) {
+	factory, ok := providerRegistry[name]
+	if !ok {
+		return nil, fmt.Errorf("market: unknown provider %q", name)
+	}
+	return factory(cfg), nil
+}
@@ -0,0 +1,175 @@
+package market
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// defaultWeightPerMin/defaultOrderPerMin 对应 Binance USDT-M 合约默认的 IP
+// 限频：每分钟 2400 权重、每分钟 1200 笔挂单。
+const (
+	defaultWeightPerMin = 2400
+	defaultOrderPerMin  = 1200
+)
+
+// RateLimitMetrics 是限速器/熔断器当前状态的只读快照，供外部监控。
+type RateLimitMetrics struct {
+	WeightUsed       int
+	WeightCap        int
+	OrderUsed        int
+	OrderCap         int
+	CircuitOpen      bool
+	CircuitOpenUntil time.Time
+}
+
+// rateLimitTransport 包装底层 http.RoundTripper，在每次响应后用 Binance 返回
+// 的 X-MBX-USED-WEIGHT-1M / X-MBX-ORDER-COUNT-1M 校正本地用量估算；当用量
+// 达到上限时阻塞到下一个整分钟；收到 429 时按 Retry-After 等待；收到 418
+// （IP 被封）时打开熔断器，在封禁期内直接快速失败而不再发请求。
+type rateLimitTransport struct {
+	next http.RoundTripper
+
+	mu          sync.Mutex
+	weightCap   int
+	orderCap    int
+	weightUsed  int
+	orderUsed   int
+	windowStart time.Time
+
+	blockedUntil     time.Time // 429 Retry-After 导致的等待截止时间
+	circuitOpenUntil time.Time // 418 封禁导致的熔断截止时间
+}
+
+func newRateLimitTransport(next http.RoundTripper, weightPerMin, orderPerMin int) *rateLimitTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	if weightPerMin <= 0 {
+		weightPerMin = defaultWeightPerMin
+	}
+	if orderPerMin <= 0 {
+		orderPerMin = defaultOrderPerMin
+	}
+	return &rateLimitTransport{
+		next:        next,
+		weightCap:   weightPerMin,
+		orderCap:    orderPerMin,
+		windowStart: time.Now().Truncate(time.Minute),
+	}
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.waitForCapacity(); err != nil {
+		return nil, err
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	t.observe(resp)
+	return resp, nil
+}
+
+// waitForCapacity 在熔断开启期间快速失败；否则在权重/挂单用量达到上限时
+// 阻塞到下一个整分钟窗口。
+func (t *rateLimitTransport) waitForCapacity() error {
+	t.mu.Lock()
+	if until := t.circuitOpenUntil; time.Now().Before(until) {
+		t.mu.Unlock()
+		return fmt.Errorf("market: 熔断器开启中（IP可能已被Binance封禁），预计 %s 后恢复", time.Until(until).Round(time.Second))
+	}
+
+	if until := t.blockedUntil; time.Now().Before(until) {
+		wait := time.Until(until)
+		t.mu.Unlock()
+		time.Sleep(wait)
+		t.mu.Lock()
+	}
+
+	if time.Since(t.windowStart) >= time.Minute {
+		t.windowStart = time.Now().Truncate(time.Minute)
+		t.weightUsed = 0
+		t.orderUsed = 0
+	}
+
+	if t.weightUsed >= t.weightCap || t.orderUsed >= t.orderCap {
+		wait := time.Minute - time.Since(t.windowStart)
+		t.mu.Unlock()
+		if wait > 0 {
+			time.Sleep(wait)
+		}
+		t.mu.Lock()
+		t.windowStart = time.Now().Truncate(time.Minute)
+		t.weightUsed = 0
+		t.orderUsed = 0
+	}
+	t.mu.Unlock()
+	return nil
+}
+
+// observe 读取响应头校正用量，并按状态码触发 429 等待 / 418 熔断。
+func (t *rateLimitTransport) observe(resp *http.Response) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if w, err := strconv.Atoi(resp.Header.Get("X-MBX-USED-WEIGHT-1M")); err == nil {
+		t.weightUsed = w
+	}
+	if o, err := strconv.Atoi(resp.Header.Get("X-MBX-ORDER-COUNT-1M")); err == nil {
+		t.orderUsed = o
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests: // 429
+		t.blockedUntil = time.Now().Add(retryAfterDuration(resp))
+	case 418: // Binance IP 封禁
+		t.circuitOpenUntil = time.Now().Add(retryAfterDuration(resp))
+	}
+}
+
+func (t *rateLimitTransport) metrics() RateLimitMetrics {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return RateLimitMetrics{
+		WeightUsed:       t.weightUsed,
+		WeightCap:        t.weightCap,
+		OrderUsed:        t.orderUsed,
+		OrderCap:         t.orderCap,
+		CircuitOpen:      time.Now().Before(t.circuitOpenUntil),
+		CircuitOpenUntil: t.circuitOpenUntil,
+	}
+}
+
+// retryAfterDuration 解析 Retry-After 响应头（秒数），解析失败或缺省时退回
+// 1 分钟，与 Binance 封禁场景通常以分钟计的粒度一致。
+func retryAfterDuration(resp *http.Response) time.Duration {
+	seconds, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	if err != nil || seconds <= 0 {
+		return time.Minute
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// WithRateLimit 给客户端底层 Transport 套上权重/挂单限速与熔断中间件，
+// weightPerMin/orderPerMin 留 0 时使用 Binance 默认上限。返回自身以便链式
+// 调用，例如 market.NewAPIClient().WithRateLimit(1200, 300)。
+func (c *APIClient) WithRateLimit(weightPerMin, orderPerMin int) *APIClient {
+	rl := newRateLimitTransport(c.client.Transport, weightPerMin, orderPerMin)
+	c.client.Transport = rl
+	c.rateLimiter = rl
+	return c
+}
+
+// Metrics 返回限速器/熔断器的当前用量快照；未调用过 WithRateLimit 时
+// ok 为 false。
+func (c *APIClient) Metrics() (metrics RateLimitMetrics, ok bool) {
+	if c.rateLimiter == nil {
+		return RateLimitMetrics{}, false
+	}
+	return c.rateLimiter.metrics(), true
+}
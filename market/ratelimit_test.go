@@ -0,0 +1,73 @@
+package market
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+func newTestResponse(status int, header http.Header) *http.Response {
+	if header == nil {
+		header = make(http.Header)
+	}
+	return &http.Response{StatusCode: status, Header: header, Body: http.NoBody}
+}
+
+func TestRateLimitTransportObserveUpdatesUsage(t *testing.T) {
+	rt := newRateLimitTransport(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		header := http.Header{}
+		header.Set("X-MBX-USED-WEIGHT-1M", "42")
+		header.Set("X-MBX-ORDER-COUNT-1M", "7")
+		return newTestResponse(http.StatusOK, header), nil
+	}), 2400, 1200)
+
+	if _, err := rt.RoundTrip(&http.Request{}); err != nil {
+		t.Fatalf("RoundTrip() error = %v", err)
+	}
+
+	metrics := rt.metrics()
+	if metrics.WeightUsed != 42 {
+		t.Errorf("WeightUsed = %d, want 42", metrics.WeightUsed)
+	}
+	if metrics.OrderUsed != 7 {
+		t.Errorf("OrderUsed = %d, want 7", metrics.OrderUsed)
+	}
+}
+
+func TestRateLimitTransportCircuitOpensOn418(t *testing.T) {
+	rt := newRateLimitTransport(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		header := http.Header{}
+		header.Set("Retry-After", "60")
+		return newTestResponse(418, header), nil
+	}), 2400, 1200)
+
+	if _, err := rt.RoundTrip(&http.Request{}); err != nil {
+		t.Fatalf("RoundTrip() error = %v", err)
+	}
+
+	metrics := rt.metrics()
+	if !metrics.CircuitOpen {
+		t.Fatalf("熔断器应在收到418后开启")
+	}
+
+	if err := rt.waitForCapacity(); err == nil {
+		t.Fatalf("熔断开启期间 waitForCapacity() 应快速失败")
+	}
+}
+
+func TestRateLimitTransportWindowResets(t *testing.T) {
+	rt := newRateLimitTransport(http.DefaultTransport, 2400, 1200)
+	rt.weightUsed = 2400
+	rt.windowStart = time.Now().Add(-2 * time.Minute)
+
+	if err := rt.waitForCapacity(); err != nil {
+		t.Fatalf("waitForCapacity() error = %v", err)
+	}
+	if rt.weightUsed != 0 {
+		t.Fatalf("过期窗口应在 waitForCapacity() 时被重置，weightUsed = %d", rt.weightUsed)
+	}
+}
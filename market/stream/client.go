@@ -0,0 +1,240 @@
+// Package stream 提供 Binance USDT-M 合约 WebSocket 行情/用户数据订阅，
+// 对外以 Go channel 的形式暴露，屏蔽底层连接管理、断线重连与组合流协议。
+package stream
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	combinedStreamURL = "wss://fstream.binance.com/stream"
+
+	// reconnect backoff 边界，指数退避并叠加随机抖动，避免大量客户端同时
+	// 断线重连时打挤服务端。
+	minBackoff = 1 * time.Second
+	maxBackoff = 30 * time.Second
+)
+
+// Client 是单条物理 WebSocket 连接上的组合流多路复用器：多次 Subscribe*
+// 调用只会打开一个底层连接，按需重连以更新订阅的流集合。
+type Client struct {
+	mu       sync.Mutex
+	proxyURL string
+	conn     *websocket.Conn
+	streams  map[string][]chan []byte // streamName -> 已注册的接收 channel
+	closed   bool
+	connGen  int // 每次重连自增，使旧的读循环在重连后能自行退出
+}
+
+// NewClient 创建一个尚未建立连接的多路复用客户端，连接会在第一次 Subscribe*
+// 时惰性建立。proxyURL 留空时直接连接，不读取 market.SetProxy 设置的全局
+// 代理。
+func NewClient(proxyURL string) *Client {
+	return &Client{
+		proxyURL: proxyURL,
+		streams:  make(map[string][]chan []byte),
+	}
+}
+
+// Close 关闭底层连接并停止所有重连尝试，已分发出去的 channel 会被关闭。
+func (c *Client) Close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closed = true
+	if c.conn != nil {
+		c.conn.Close()
+	}
+	for _, chans := range c.streams {
+		for _, ch := range chans {
+			close(ch)
+		}
+	}
+	c.streams = make(map[string][]chan []byte)
+}
+
+// subscribe 注册一个原始流名（如 "btcusdt@kline_1m"）并返回接收原始 payload
+// 的 channel，底层按需重连以把该流加入组合连接。
+func (c *Client) subscribe(streamName string) <-chan []byte {
+	ch := make(chan []byte, 64)
+
+	c.mu.Lock()
+	c.streams[streamName] = append(c.streams[streamName], ch)
+	c.mu.Unlock()
+
+	c.reconnect()
+	return ch
+}
+
+// reconnect 用当前完整的订阅集合重建底层连接，并启动新的读循环；旧的读
+// 循环通过 connGen 比对自行退出，不会与新连接的分发产生竞争。
+func (c *Client) reconnect() {
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return
+	}
+	if c.conn != nil {
+		c.conn.Close()
+	}
+	streamNames := make([]string, 0, len(c.streams))
+	for name := range c.streams {
+		streamNames = append(streamNames, name)
+	}
+	c.connGen++
+	gen := c.connGen
+	c.mu.Unlock()
+
+	go c.dialAndServe(streamNames, gen)
+}
+
+func (c *Client) dialAndServe(streamNames []string, gen int) {
+	backoff := minBackoff
+	for attempt := 0; ; attempt++ {
+		c.mu.Lock()
+		if c.closed || gen != c.connGen {
+			c.mu.Unlock()
+			return
+		}
+		c.mu.Unlock()
+
+		conn, err := c.dial(streamNames)
+		if err != nil {
+			log.Printf("market/stream: 连接失败(第%d次重试): %v", attempt+1, err)
+			time.Sleep(backoff + time.Duration(rand.Int63n(int64(backoff)/2+1)))
+			backoff *= 2
+			if backoff > maxBackoff {
+				backoff = maxBackoff
+			}
+			continue
+		}
+
+		c.mu.Lock()
+		if c.closed || gen != c.connGen {
+			c.mu.Unlock()
+			conn.Close()
+			return
+		}
+		c.conn = conn
+		c.mu.Unlock()
+
+		// 连接成功，读循环会一直阻塞直到出错或被上层重连替换。
+		c.readLoop(conn, gen)
+
+		c.mu.Lock()
+		stillCurrent := !c.closed && gen == c.connGen
+		c.mu.Unlock()
+		if !stillCurrent {
+			return
+		}
+		backoff = minBackoff // 成功连接过，重置退避
+	}
+}
+
+func (c *Client) dial(streamNames []string) (*websocket.Conn, error) {
+	dialer := websocket.Dialer{HandshakeTimeout: 10 * time.Second}
+	if c.proxyURL != "" {
+		proxyParsed, err := url.Parse(c.proxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("market/stream: 代理地址解析失败: %w", err)
+		}
+		dialer.Proxy = func(*http.Request) (*url.URL, error) { return proxyParsed, nil }
+	}
+
+	target := combinedStreamURL
+	if len(streamNames) > 0 {
+		target = fmt.Sprintf("%s?streams=%s", combinedStreamURL, strings.Join(streamNames, "/"))
+	}
+
+	conn, _, err := dialer.Dial(target, nil)
+	if err != nil {
+		return nil, err
+	}
+	return conn, nil
+}
+
+// combinedMessage 对应 /stream?streams=... 端点的信封格式。
+type combinedMessage struct {
+	Stream string          `json:"stream"`
+	Data   json.RawMessage `json:"data"`
+}
+
+func (c *Client) readLoop(conn *websocket.Conn, gen int) {
+	for {
+		messageType, raw, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		payload, err := decompressFrame(messageType, raw)
+		if err != nil {
+			log.Printf("market/stream: 解压帧失败: %v", err)
+			continue
+		}
+
+		var msg combinedMessage
+		if err := json.Unmarshal(payload, &msg); err != nil {
+			continue
+		}
+
+		c.mu.Lock()
+		if gen != c.connGen {
+			c.mu.Unlock()
+			return
+		}
+		subscribers := c.streams[msg.Stream]
+		c.mu.Unlock()
+
+		for _, ch := range subscribers {
+			dispatch(ch, msg.Data)
+		}
+	}
+}
+
+// dispatch 把一条消息放入订阅者 channel；channel 已满（消费端跟不上）时
+// 丢弃最旧的一条腾出空间再放入最新数据，而不是阻塞整个读循环或让消费端
+// 停留在旧数据上。
+func dispatch(ch chan []byte, data []byte) {
+	select {
+	case ch <- data:
+	default:
+		select {
+		case <-ch:
+		default:
+		}
+		select {
+		case ch <- data:
+		default:
+		}
+	}
+}
+
+// decompressFrame 解开可能被 gzip 压缩的二进制帧。Binance 合约默认不压缩，
+// 但部分部署环境/代理会对二进制帧做 gzip（与 Huobi 按 Content-Encoding
+// 判断是否 gzip 的做法类似），因此对二进制帧再按 gzip magic number 探测，
+// 而不是把所有二进制帧都当作压缩数据；文本帧原样返回。
+func decompressFrame(messageType int, raw []byte) ([]byte, error) {
+	if messageType != websocket.BinaryMessage || len(raw) < 2 || raw[0] != 0x1f || raw[1] != 0x8b {
+		return raw, nil
+	}
+
+	reader, err := gzip.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	return io.ReadAll(reader)
+}
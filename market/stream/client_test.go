@@ -0,0 +1,69 @@
+package stream
+
+import (
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestDecompressFramePassesThroughUncompressed(t *testing.T) {
+	raw := []byte(`{"stream":"btcusdt@kline_1m","data":{}}`)
+	got, err := decompressFrame(websocket.TextMessage, raw)
+	if err != nil {
+		t.Fatalf("decompressFrame() error = %v", err)
+	}
+	if !bytes.Equal(got, raw) {
+		t.Fatalf("decompressFrame() = %q, want %q unchanged", got, raw)
+	}
+}
+
+func TestDecompressFrameGunzipsBinaryFrames(t *testing.T) {
+	want := []byte(`{"stream":"btcusdt@kline_1m","data":{}}`)
+
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if _, err := writer.Write(want); err != nil {
+		t.Fatalf("gzip.Write() error = %v", err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("gzip.Close() error = %v", err)
+	}
+
+	got, err := decompressFrame(websocket.BinaryMessage, buf.Bytes())
+	if err != nil {
+		t.Fatalf("decompressFrame() error = %v", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Fatalf("decompressFrame() = %q, want %q", got, want)
+	}
+}
+
+func TestDispatchDropsOldestWhenChannelFull(t *testing.T) {
+	ch := make(chan []byte, 2)
+	ch <- []byte("oldest")
+	ch <- []byte("older")
+
+	dispatch(ch, []byte("newest"))
+
+	first := <-ch
+	second := <-ch
+	if string(first) != "older" || string(second) != "newest" {
+		t.Fatalf("dispatch() 应丢弃最旧消息并保留最新消息, got [%q, %q]", first, second)
+	}
+}
+
+func TestDispatchDeliversWhenChannelHasRoom(t *testing.T) {
+	ch := make(chan []byte, 1)
+	dispatch(ch, []byte("hello"))
+
+	select {
+	case got := <-ch:
+		if string(got) != "hello" {
+			t.Fatalf("dispatch() 投递了错误的消息: %q", got)
+		}
+	default:
+		t.Fatalf("dispatch() 在channel有空位时应该投递消息")
+	}
+}
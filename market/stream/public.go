@@ -0,0 +1,270 @@
+package stream
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/xuanyustudio/nofx/market"
+)
+
+// AggTrade 是归集交易流（<symbol>@aggTrade）的单条事件。
+type AggTrade struct {
+	Symbol       string
+	AggTradeID   int64
+	Price        float64
+	Quantity     float64
+	TradeTime    int64
+	IsBuyerMaker bool
+}
+
+// BookTicker 是最优挂单流（<symbol>@bookTicker）的单条事件。
+type BookTicker struct {
+	Symbol   string
+	BidPrice float64
+	BidQty   float64
+	AskPrice float64
+	AskQty   float64
+}
+
+// DepthUpdate 是增量深度流（<symbol>@depth）的单条事件，Bids/Asks 中的档位
+// 为本次增量的变化，不是完整订单簿快照。
+type DepthUpdate struct {
+	Symbol        string
+	FirstUpdateID int64
+	FinalUpdateID int64
+	Bids          []market.DepthLevel
+	Asks          []market.DepthLevel
+}
+
+func lowerSymbol(symbol string) string {
+	return strings.ToLower(strings.NewReplacer("-", "", "_", "", "/", "").Replace(symbol))
+}
+
+// SubscribeKline 订阅 K 线流，symbol/interval 与 REST GetKlines 的入参一致，
+// 例如 SubscribeKline("BTCUSDT", "1m")。
+func (c *Client) SubscribeKline(symbol, interval string) <-chan market.Kline {
+	raw := c.subscribe(fmt.Sprintf("%s@kline_%s", lowerSymbol(symbol), interval))
+	out := make(chan market.Kline, 64)
+
+	go func() {
+		defer close(out)
+		for payload := range raw {
+			var event struct {
+				Kline struct {
+					OpenTime            int64  `json:"t"`
+					CloseTime           int64  `json:"T"`
+					Open                string `json:"o"`
+					Close               string `json:"c"`
+					High                string `json:"h"`
+					Low                 string `json:"l"`
+					Volume              string `json:"v"`
+					Trades              int    `json:"n"`
+					QuoteVolume         string `json:"q"`
+					TakerBuyBaseVolume  string `json:"V"`
+					TakerBuyQuoteVolume string `json:"Q"`
+				} `json:"k"`
+			}
+			if err := json.Unmarshal(payload, &event); err != nil {
+				log.Printf("market/stream: 解析K线事件失败: %v", err)
+				continue
+			}
+
+			k := market.Kline{
+				OpenTime:  event.Kline.OpenTime,
+				CloseTime: event.Kline.CloseTime,
+				Trades:    event.Kline.Trades,
+			}
+			fields := []struct {
+				name  string
+				value string
+				dst   *float64
+			}{
+				{"open", event.Kline.Open, &k.Open},
+				{"close", event.Kline.Close, &k.Close},
+				{"high", event.Kline.High, &k.High},
+				{"low", event.Kline.Low, &k.Low},
+				{"volume", event.Kline.Volume, &k.Volume},
+				{"quoteVolume", event.Kline.QuoteVolume, &k.QuoteVolume},
+				{"takerBuyBaseVolume", event.Kline.TakerBuyBaseVolume, &k.TakerBuyBaseVolume},
+				{"takerBuyQuoteVolume", event.Kline.TakerBuyQuoteVolume, &k.TakerBuyQuoteVolume},
+			}
+			parseErr := false
+			for _, f := range fields {
+				v, err := strconv.ParseFloat(f.value, 64)
+				if err != nil {
+					log.Printf("market/stream: 解析K线事件字段%s失败: %v", f.name, err)
+					parseErr = true
+					break
+				}
+				*f.dst = v
+			}
+			if parseErr {
+				continue
+			}
+
+			out <- k
+		}
+	}()
+
+	return out
+}
+
+// SubscribeAggTrade 订阅归集交易流。
+func (c *Client) SubscribeAggTrade(symbol string) <-chan AggTrade {
+	raw := c.subscribe(fmt.Sprintf("%s@aggTrade", lowerSymbol(symbol)))
+	out := make(chan AggTrade, 64)
+
+	go func() {
+		defer close(out)
+		for payload := range raw {
+			var event struct {
+				Symbol       string `json:"s"`
+				AggTradeID   int64  `json:"a"`
+				Price        string `json:"p"`
+				Quantity     string `json:"q"`
+				TradeTime    int64  `json:"T"`
+				IsBuyerMaker bool   `json:"m"`
+			}
+			if err := json.Unmarshal(payload, &event); err != nil {
+				log.Printf("market/stream: 解析归集交易事件失败: %v", err)
+				continue
+			}
+
+			trade := AggTrade{
+				Symbol:       event.Symbol,
+				AggTradeID:   event.AggTradeID,
+				TradeTime:    event.TradeTime,
+				IsBuyerMaker: event.IsBuyerMaker,
+			}
+			price, err := strconv.ParseFloat(event.Price, 64)
+			if err != nil {
+				log.Printf("market/stream: 解析归集交易字段price失败: %v", err)
+				continue
+			}
+			quantity, err := strconv.ParseFloat(event.Quantity, 64)
+			if err != nil {
+				log.Printf("market/stream: 解析归集交易字段quantity失败: %v", err)
+				continue
+			}
+			trade.Price = price
+			trade.Quantity = quantity
+
+			out <- trade
+		}
+	}()
+
+	return out
+}
+
+// SubscribeBookTicker 订阅最优挂单流。
+func (c *Client) SubscribeBookTicker(symbol string) <-chan BookTicker {
+	raw := c.subscribe(fmt.Sprintf("%s@bookTicker", lowerSymbol(symbol)))
+	out := make(chan BookTicker, 64)
+
+	go func() {
+		defer close(out)
+		for payload := range raw {
+			var event struct {
+				Symbol   string `json:"s"`
+				BidPrice string `json:"b"`
+				BidQty   string `json:"B"`
+				AskPrice string `json:"a"`
+				AskQty   string `json:"A"`
+			}
+			if err := json.Unmarshal(payload, &event); err != nil {
+				log.Printf("market/stream: 解析最优挂单事件失败: %v", err)
+				continue
+			}
+
+			ticker := BookTicker{Symbol: event.Symbol}
+			fields := []struct {
+				name  string
+				value string
+				dst   *float64
+			}{
+				{"bidPrice", event.BidPrice, &ticker.BidPrice},
+				{"bidQty", event.BidQty, &ticker.BidQty},
+				{"askPrice", event.AskPrice, &ticker.AskPrice},
+				{"askQty", event.AskQty, &ticker.AskQty},
+			}
+			parseErr := false
+			for _, f := range fields {
+				v, err := strconv.ParseFloat(f.value, 64)
+				if err != nil {
+					log.Printf("market/stream: 解析最优挂单字段%s失败: %v", f.name, err)
+					parseErr = true
+					break
+				}
+				*f.dst = v
+			}
+			if parseErr {
+				continue
+			}
+
+			out <- ticker
+		}
+	}()
+
+	return out
+}
+
+// SubscribeDepth 订阅增量深度流，updateSpeed 取 "" / "100ms" / "500ms"。
+func (c *Client) SubscribeDepth(symbol, updateSpeed string) <-chan DepthUpdate {
+	streamName := fmt.Sprintf("%s@depth", lowerSymbol(symbol))
+	if updateSpeed != "" {
+		streamName = fmt.Sprintf("%s@%s", streamName, updateSpeed)
+	}
+	raw := c.subscribe(streamName)
+	out := make(chan DepthUpdate, 64)
+
+	go func() {
+		defer close(out)
+		for payload := range raw {
+			var event struct {
+				Symbol        string     `json:"s"`
+				FirstUpdateID int64      `json:"U"`
+				FinalUpdateID int64      `json:"u"`
+				Bids          [][]string `json:"b"`
+				Asks          [][]string `json:"a"`
+			}
+			if err := json.Unmarshal(payload, &event); err != nil {
+				log.Printf("market/stream: 解析深度事件失败: %v", err)
+				continue
+			}
+
+			update := DepthUpdate{
+				Symbol:        event.Symbol,
+				FirstUpdateID: event.FirstUpdateID,
+				FinalUpdateID: event.FinalUpdateID,
+			}
+			update.Bids = parseLevels(event.Bids)
+			update.Asks = parseLevels(event.Asks)
+
+			out <- update
+		}
+	}()
+
+	return out
+}
+
+func parseLevels(raw [][]string) []market.DepthLevel {
+	levels := make([]market.DepthLevel, 0, len(raw))
+	for _, level := range raw {
+		if len(level) < 2 {
+			continue
+		}
+		price, err := strconv.ParseFloat(level[0], 64)
+		if err != nil {
+			continue
+		}
+		qty, err := strconv.ParseFloat(level[1], 64)
+		if err != nil {
+			continue
+		}
+		levels = append(levels, market.DepthLevel{Price: price, Quantity: qty})
+	}
+	return levels
+}
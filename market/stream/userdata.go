@@ -0,0 +1,84 @@
+package stream
+
+import (
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/xuanyustudio/nofx/market"
+)
+
+// listenKeyRenewInterval 是 listenKey 的续期周期。Binance 要求 60 分钟内
+// 续期一次，这里取一半作为安全余量。
+const listenKeyRenewInterval = 30 * time.Minute
+
+// UserDataEvent 是用户数据流的单条事件，EventType 取值如
+// "ORDER_TRADE_UPDATE"、"ACCOUNT_UPDATE"、"MARGIN_CALL"。Raw 是完整的事件
+// JSON，调用方按需解析为具体事件结构（如 ORDER_TRADE_UPDATE 事件中 "o" 字段
+// 对应 OrderUpdate）。
+type UserDataEvent struct {
+	EventType string
+	EventTime int64
+	Raw       json.RawMessage
+}
+
+// OrderUpdate 对应 ORDER_TRADE_UPDATE 事件中的订单更新内容。
+type OrderUpdate struct {
+	Symbol        string           `json:"s"`
+	ClientOrderID string           `json:"c"`
+	Side          market.OrderSide `json:"S"`
+	OrderType     market.OrderType `json:"o"`
+	OrderStatus   string           `json:"X"`
+	OrderID       int64            `json:"i"`
+	LastFilledQty string           `json:"l"`
+	FilledQty     string           `json:"z"`
+	LastFillPrice string           `json:"L"`
+	OrderTime     int64            `json:"T"`
+}
+
+// SubscribeUserData 订阅用户数据流（订单成交、账户余额/持仓变化）。listenKey
+// 需由调用方通过 authClient.CreateListenKey() 预先申请；本方法在后台按
+// listenKeyRenewInterval 自动续期；续期失败只记录日志，等下一个周期再试。
+// Client.Close 后 events channel 会被关闭。
+func (c *Client) SubscribeUserData(authClient *market.AuthenticatedClient, listenKey string) <-chan UserDataEvent {
+	raw := c.subscribe(listenKey)
+	out := make(chan UserDataEvent, 64)
+
+	stopRenew := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(listenKeyRenewInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if err := authClient.KeepAliveListenKey(); err != nil {
+					log.Printf("market/stream: listenKey续期失败: %v", err)
+				}
+			case <-stopRenew:
+				return
+			}
+		}
+	}()
+
+	go func() {
+		defer close(out)
+		defer close(stopRenew)
+		for payload := range raw {
+			var event struct {
+				EventType string `json:"e"`
+				EventTime int64  `json:"E"`
+			}
+			if err := json.Unmarshal(payload, &event); err != nil {
+				log.Printf("market/stream: 解析用户数据事件失败: %v", err)
+				continue
+			}
+			out <- UserDataEvent{
+				EventType: event.EventType,
+				EventTime: event.EventTime,
+				Raw:       payload,
+			}
+		}
+	}()
+
+	return out
+}
@@ -0,0 +1,47 @@
+package market
+
+import "testing"
+
+func TestNormalizeBinanceSymbol(t *testing.T) {
+	cases := map[string]string{
+		"BTCUSDT":  "BTCUSDT",
+		"btcusdt":  "BTCUSDT",
+		"BTC-USDT": "BTCUSDT",
+		"btc_usdt": "BTCUSDT",
+		"BTC/USDT": "BTCUSDT",
+	}
+	for in, want := range cases {
+		if got := normalizeBinanceSymbol(in); got != want {
+			t.Errorf("normalizeBinanceSymbol(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestNormalizeOKXSymbol(t *testing.T) {
+	cases := map[string]string{
+		"BTCUSDT":  "BTC-USDT",
+		"btcusdt":  "BTC-USDT",
+		"BTC-USDT": "BTC-USDT",
+		"btc_usdt": "BTC-USDT",
+		"ETHBTC":   "ETH-BTC",
+	}
+	for in, want := range cases {
+		if got := normalizeOKXSymbol(in); got != want {
+			t.Errorf("normalizeOKXSymbol(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestNormalizeKucoinSymbol(t *testing.T) {
+	// Kucoin现货symbol就是"BTC-USDT"这种写法，不应该被重写成XBT。
+	cases := map[string]string{
+		"BTCUSDT":  "BTC-USDT",
+		"btcusdt":  "BTC-USDT",
+		"BTC-USDT": "BTC-USDT",
+	}
+	for in, want := range cases {
+		if got := normalizeKucoinSymbol(in); got != want {
+			t.Errorf("normalizeKucoinSymbol(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package market
+
+// Kline 是各交易所 K 线数据归一化后的统一表示，策略层只与此结构打交道，
+// 不感知具体交易所的字段顺序或命名差异。
+type Kline struct {
+	OpenTime            int64
+	Open                float64
+	High                float64
+	Low                 float64
+	Close               float64
+	Volume              float64
+	CloseTime           int64
+	QuoteVolume         float64
+	Trades              int
+	TakerBuyBaseVolume  float64
+	TakerBuyQuoteVolume float64
+}
+
+// Ticker 是统一的最新价格/报价表示。
+type Ticker struct {
+	Symbol string
+	Price  float64
+}
+
+// PriceTicker 对应 Binance ticker/price 接口的原始返回结构。
+type PriceTicker struct {
+	Symbol string `json:"symbol"`
+	Price  string `json:"price"`
+}
+
+// SymbolInfo 描述单个交易对的基础信息。
+type SymbolInfo struct {
+	Symbol     string `json:"symbol"`
+	BaseAsset  string `json:"baseAsset"`
+	QuoteAsset string `json:"quoteAsset"`
+	Status     string `json:"status"`
+}
+
+// ExchangeInfo 是交易所支持的交易对集合。
+type ExchangeInfo struct {
+	Symbols []SymbolInfo `json:"symbols"`
+}
+
+// FundingRate 是合约资金费率信息，现货类 Provider 可不实现或返回 nil。
+type FundingRate struct {
+	Symbol      string
+	FundingRate float64
+	FundingTime int64
+}
+
+// DepthLevel 是订单簿中的一档报价，Price/Quantity 均已转换为 float64。
+type DepthLevel struct {
+	Price    float64
+	Quantity float64
+}
+
+// Depth 是统一的订单簿深度表示。
+type Depth struct {
+	Symbol       string
+	LastUpdateID int64
+	Bids         []DepthLevel
+	Asks         []DepthLevel
+}